@@ -0,0 +1,28 @@
+/*
+
+Command notify-alert is a small client that opens PagerDuty or Opsgenie
+incidents for jobs that fail on the default branch, and resolves them when
+they pass, as chosen by the scheme of the hook URL.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/alert"
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		alert.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
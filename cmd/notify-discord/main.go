@@ -0,0 +1,27 @@
+/*
+
+Command notify-discord is a small webhook client that forms and posts a Discord
+webhook request to notify about GitHub workflow events.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/discord"
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		discord.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
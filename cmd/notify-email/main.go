@@ -0,0 +1,27 @@
+/*
+
+Command notify-email is a small SMTP client that forms and sends an email to
+notify about GitHub workflow events.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/email"
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		email.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,78 @@
+/*
+
+Command notify-fanout sends notifications about GitHub workflow events to
+several destinations at once, each with its own backend.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/alert"
+	"github.com/MichaelUrman/notify/internal/discord"
+	"github.com/MichaelUrman/notify/internal/email"
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/googlechat"
+	"github.com/MichaelUrman/notify/internal/matrix"
+	"github.com/MichaelUrman/notify/internal/mattermost"
+	"github.com/MichaelUrman/notify/internal/notifier"
+	"github.com/MichaelUrman/notify/internal/push"
+	"github.com/MichaelUrman/notify/internal/rocketchat"
+	"github.com/MichaelUrman/notify/internal/slack"
+	"github.com/MichaelUrman/notify/internal/teams"
+	"github.com/MichaelUrman/notify/internal/webhook"
+)
+
+func main() {
+	backends := map[string]notifier.EventPreparer{
+		"alert":      alert.BuildSubmitter,
+		"discord":    discord.BuildSubmitter,
+		"email":      email.BuildSubmitter,
+		"googlechat": googlechat.BuildSubmitter,
+		"matrix":     matrix.Preparer(github.Actions, github.Actions.Input("room")),
+		"mattermost": mattermost.BuildSubmitter,
+		"push":       push.BuildSubmitter,
+		"rocketchat": rocketchat.BuildSubmitter,
+		"slack":      slack.BuildSubmitter,
+		"teams":      teams.BuildSubmitter,
+		"adaptive":   teams.BuildAdaptiveSubmitter,
+	}
+	// The templated webhook has nothing to send without a template, which
+	// is checked once the destinations say whether it's needed.
+	var hook *webhook.Hook
+	if text := github.Actions.Input("template"); text != "" {
+		var err error
+		hook, err = webhook.New(
+			text,
+			github.Actions.Input("method"),
+			github.Actions.Secret("headers"),
+			github.Actions.Input("content-type"),
+		)
+		if err != nil {
+			github.Actions.Fatalf("%v", err)
+		}
+	}
+	backends["webhook"] = hook.BuildSubmitter
+
+	dests, err := notifier.ParseDestinations(github.Actions.Input("destinations"), backends)
+	if err != nil {
+		github.Actions.Fatalf("%v", err)
+	}
+	for _, d := range dests {
+		github.Actions.Mask(d.URL)
+		if d.Kind == "webhook" && hook == nil {
+			github.Actions.Fatalf("webhook destination needs a template")
+		}
+	}
+
+	err = notifier.MainAll(
+		github.Actions,
+		github.LoadEvent,
+		dests,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,27 @@
+/*
+
+Command notify-matrix is a small Matrix client that forms and sends a room
+message to notify about GitHub workflow events.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/matrix"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		matrix.Preparer(github.Actions, github.Actions.Input("room")),
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
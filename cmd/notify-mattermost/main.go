@@ -0,0 +1,27 @@
+/*
+
+Command notify-mattermost is a small webhook client that forms and posts a
+Mattermost webhook request to notify about GitHub workflow events.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/mattermost"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		mattermost.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,28 @@
+/*
+
+Command notify-push is a small client that sends push notifications about
+GitHub workflow events through Telegram, ntfy or Gotify, as chosen by the
+scheme of the hook URL.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/notifier"
+	"github.com/MichaelUrman/notify/internal/push"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		push.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
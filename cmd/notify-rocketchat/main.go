@@ -0,0 +1,27 @@
+/*
+
+Command notify-rocketchat is a small webhook client that forms and posts a
+Rocket.Chat webhook request to notify about GitHub workflow events.
+
+*/
+package main
+
+import (
+	"os"
+
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/notifier"
+	"github.com/MichaelUrman/notify/internal/rocketchat"
+)
+
+func main() {
+	err := notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		rocketchat.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
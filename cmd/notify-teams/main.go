@@ -15,10 +15,15 @@ import (
 )
 
 func main() {
+	prepare := teams.BuildSubmitter
+	if github.Actions.Input("card") == "adaptive" {
+		prepare = teams.BuildAdaptiveSubmitter
+	}
+
 	err := notifier.Main(
 		github.Actions,
 		github.LoadEvent,
-		teams.BuildSubmitter,
+		prepare,
 	)
 	if err != nil {
 		os.Exit(1)
@@ -0,0 +1,65 @@
+/*
+
+Command notify-webhook is a small webhook client that renders a request from a
+Go text/template to notify about GitHub workflow events.
+
+With -dry-run, it prints the rendered body instead of sending it.
+
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/notifier"
+	"github.com/MichaelUrman/notify/internal/webhook"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "print the rendered body instead of sending it")
+	flag.Parse()
+
+	text := github.Actions.Input("template")
+	if path := github.Actions.Input("template-file"); path != "" {
+		// The action runs in its own directory, so find the file in the workspace.
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(os.Getenv("GITHUB_WORKSPACE"), path)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			github.Actions.Fatalf("reading template: %v", err)
+		}
+		text = string(b)
+	}
+	hook, err := webhook.New(
+		text,
+		github.Actions.Input("method"),
+		github.Actions.Secret("headers"),
+		github.Actions.Input("content-type"),
+	)
+	if err != nil {
+		github.Actions.Fatalf("%v", err)
+	}
+
+	if *dryRun {
+		if err := hook.DryRun(context.Background(), github.LoadEvent, os.Stdout); err != nil {
+			github.Actions.Fatalf("%v", err)
+		}
+		os.Exit(0)
+	}
+
+	err = notifier.Main(
+		github.Actions,
+		github.LoadEvent,
+		hook.BuildSubmitter,
+	)
+	if err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
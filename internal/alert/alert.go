@@ -0,0 +1,118 @@
+// Package alert opens incidents for jobs that fail on the default branch and
+// resolves them when a later run passes, choosing the service by the scheme
+// of the hook URL:
+//
+//	pagerduty://routing-key@events.pagerduty.com
+//	opsgenie://api-key@api.opsgenie.com
+//
+// Both use HTTPS; pagerduty+http and opsgenie+http reach servers without it.
+package alert
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+)
+
+// Request holds the details of a job status, which become a trigger or
+// resolve event once Submit knows which service to send it to.
+type Request struct {
+	Detail event.Detail
+}
+
+type action int
+
+const (
+	none action = iota
+	trigger
+	resolve
+)
+
+const source = "GitHub Actions"
+
+func BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return Build(d)
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	return &Request{*d}
+}
+
+// action decides what a job status means for an incident. Only runs on the
+// default branch open or resolve incidents, and skipped jobs do neither.
+func (r Request) action() action {
+	d := r.Detail
+	if d.Branch == "" || d.Branch != d.DefaultBranch {
+		return none
+	}
+	switch d.Status {
+	case "success":
+		return resolve
+	case "failure", "cancelled", "timed_out":
+		return trigger
+	}
+	return none
+}
+
+// maxKey is the longest deduplication key PagerDuty accepts.
+const maxKey = 255
+
+// dedupKey identifies the incident for a workflow on a branch, so that each
+// failure updates the same incident and a pass resolves it.
+func (r Request) dedupKey() string {
+	key := r.Detail.Repository + "/" + r.Detail.Workflow + "@" + r.Detail.Branch
+	if len(key) > maxKey {
+		sum := sha256.Sum256([]byte(key))
+		key = hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+func (r Request) Submit(ctx context.Context, hook string) error {
+	act := r.action()
+	if act == none {
+		return nil
+	}
+	u, err := url.Parse(hook)
+	if err != nil {
+		return fmt.Errorf("parsing hook URL: %w", err)
+	}
+	service, scheme := u.Scheme, "https"
+	if i := strings.IndexByte(service, '+'); i >= 0 {
+		service, scheme = service[:i], service[i+1:]
+	}
+	key := u.User.Username()
+	if key == "" || u.Host == "" {
+		return fmt.Errorf("%s URL needs a key and host", service)
+	}
+	base := scheme + "://" + u.Host
+	switch service {
+	case "pagerduty":
+		return r.pagerDuty(ctx, base, key, act)
+	case "opsgenie":
+		return r.opsgenie(ctx, base, key, act)
+	}
+	return fmt.Errorf("unsupported scheme %q; want pagerduty or opsgenie", u.Scheme)
+}
+
+// details lists the facts and the rest of the text, for the incident's
+// custom details.
+func (r Request) details() map[string]string {
+	details := map[string]string{}
+	for _, f := range r.Detail.Fact {
+		details[markdown.Plain.Convert(f.Name)] = markdown.Plain.Convert(f.Value)
+	}
+	if r.Detail.Body != "" {
+		details["Details"] = markdown.Plain.Convert(r.Detail.Body)
+	}
+	return details
+}
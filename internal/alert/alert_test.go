@@ -0,0 +1,154 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+func status(status, branch string) *event.Detail {
+	return &event.Detail{
+		Summary:       "Integration Test failed for " + branch,
+		Repository:    "orgname/reponame",
+		Status:        status,
+		Workflow:      "Integration Test",
+		Branch:        branch,
+		DefaultBranch: "main",
+		Text:          "❌ Integration Test failed for **" + branch + "**",
+		Fact:          []event.Fact{{Name: "Attempt", Value: "2"}},
+		Action:        []event.Action{{Name: "View run", URL: "https://github.com/orgname/reponame/actions/runs/12345"}},
+	}
+}
+
+// request is what the stub server was sent.
+type request struct {
+	path, query, auth string
+	body              map[string]interface{}
+}
+
+func stub(t *testing.T) (string, *[]request) {
+	var got []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		req := request{r.URL.EscapedPath(), r.URL.RawQuery, r.Header.Get("Authorization"), nil}
+		if err := json.Unmarshal(data, &req.body); err != nil {
+			t.Errorf("decoding %s: %v", data, err)
+		}
+		got = append(got, req)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "http://"), &got
+}
+
+func TestPagerDuty(t *testing.T) {
+	host, got := stub(t)
+	for _, d := range []*event.Detail{
+		status("failure", "main"),
+		status("failure", "feature"),
+		status("skipped", "main"),
+		status("cancelled", "main"),
+		status("success", "main"),
+		{Summary: "username pushed main", Repository: "orgname/reponame"},
+	} {
+		if err := Build(d).Submit(context.Background(), "pagerduty+http://R0UT1NG@"+host); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	trigger := func(severity, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"routing_key":  "R0UT1NG",
+			"event_action": "trigger",
+			"dedup_key":    "orgname/reponame/Integration Test@main",
+			"payload": map[string]interface{}{
+				"summary":        "Integration Test failed for main",
+				"source":         "orgname/reponame",
+				"severity":       severity,
+				"component":      "Integration Test",
+				"group":          "main",
+				"class":          status,
+				"custom_details": map[string]interface{}{"Attempt": "2"},
+			},
+			"client":     "GitHub Actions",
+			"client_url": "https://github.com/orgname/reponame/actions/runs/12345",
+			"links":      []interface{}{map[string]interface{}{"href": "https://github.com/orgname/reponame/actions/runs/12345", "text": "View run"}},
+		}
+	}
+	want := []request{
+		{path: "/v2/enqueue", body: trigger("error", "failure")},
+		{path: "/v2/enqueue", body: trigger("warning", "cancelled")},
+		{path: "/v2/enqueue", body: map[string]interface{}{
+			"routing_key":  "R0UT1NG",
+			"event_action": "resolve",
+			"dedup_key":    "orgname/reponame/Integration Test@main",
+		}},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("got %#v\nwant %#v", *got, want)
+	}
+}
+
+func TestOpsgenie(t *testing.T) {
+	host, got := stub(t)
+	for _, d := range []*event.Detail{
+		status("failure", "main"),
+		status("failure", "feature"),
+		status("success", "main"),
+	} {
+		if err := Build(d).Submit(context.Background(), "opsgenie+http://k3y@"+host); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []request{
+		{path: "/v2/alerts", auth: "GenieKey k3y", body: map[string]interface{}{
+			"message":     "Integration Test failed for main",
+			"alias":       "orgname/reponame/Integration Test@main",
+			"description": "❌ Integration Test failed for main\n\nView run: https://github.com/orgname/reponame/actions/runs/12345",
+			"entity":      "orgname/reponame",
+			"source":      "GitHub Actions",
+			"priority":    "P2",
+			"tags":        []interface{}{"failure"},
+			"details":     map[string]interface{}{"Attempt": "2"},
+		}},
+		{path: "/v2/alerts/orgname%2Freponame%2FIntegration%20Test@main/close", query: "identifierType=alias", auth: "GenieKey k3y", body: map[string]interface{}{
+			"source": "GitHub Actions",
+			"note":   "Integration Test failed for main",
+		}},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("got %#v\nwant %#v", *got, want)
+	}
+}
+
+func TestDedupKey(t *testing.T) {
+	d := status("failure", "main")
+	d.Workflow = strings.Repeat("w", maxKey)
+	key := Build(d).dedupKey()
+	if len(key) > maxKey {
+		t.Errorf("key is %d bytes; want at most %d", len(key), maxKey)
+	}
+	if key != Build(d).dedupKey() {
+		t.Error("key is not stable")
+	}
+}
+
+func TestSubmitErrors(t *testing.T) {
+	for _, hook := range []string{
+		"https://example.com/hook",
+		"pagerduty://events.pagerduty.com",
+		"opsgenie://key@",
+	} {
+		if err := Build(status("failure", "main")).Submit(context.Background(), hook); err == nil {
+			t.Errorf("Submit(%q): want error", hook)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// opsgenieAlert creates an alert, or updates the open one with its alias.
+//
+// Reference: https://docs.opsgenie.com/docs/alert-api
+type opsgenieAlert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description,omitempty"`
+	Entity      string            `json:"entity,omitempty"`
+	Source      string            `json:"source"`
+	Priority    string            `json:"priority"`
+	Tags        []string          `json:"tags,omitempty"`
+	Details     map[string]string `json:"details,omitempty"`
+}
+
+type opsgenieClose struct {
+	Source string `json:"source"`
+	Note   string `json:"note,omitempty"`
+}
+
+const (
+	maxOpsgenieMessage     = 130
+	maxOpsgenieDescription = 15000
+)
+
+// opsgeniePriority rates the job statuses that open alerts.
+var opsgeniePriority = map[string]string{
+	"failure":   "P2",
+	"timed_out": "P2",
+	"cancelled": "P4",
+}
+
+func (r Request) opsgenie(ctx context.Context, base, apiKey string, act action) error {
+	d := r.Detail
+	endpoint := base + "/v2/alerts"
+	var body interface{} = opsgenieClose{Source: source, Note: markdown.Plain.Convert(d.Summary)}
+	if act == resolve {
+		endpoint += "/" + url.PathEscape(r.dedupKey()) + "/close?identifierType=alias"
+	} else {
+		var description []string
+		for _, t := range []string{d.Text, d.Body} {
+			if t != "" {
+				description = append(description, markdown.Plain.Convert(t))
+			}
+		}
+		for _, a := range d.Action {
+			description = append(description, a.Name+": "+a.URL)
+		}
+		body = opsgenieAlert{
+			Message:     markdown.Truncate(markdown.Plain.Convert(d.Summary), maxOpsgenieMessage),
+			Alias:       r.dedupKey(),
+			Description: markdown.Truncate(strings.Join(description, "\n\n"), maxOpsgenieDescription),
+			Entity:      d.Repository,
+			Source:      source,
+			Priority:    opsgeniePriority[d.Status],
+			Tags:        []string{d.Status},
+			Details:     r.details(),
+		}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+	header := http.Header{
+		"Authorization": {"GenieKey " + apiKey},
+		"Content-Type":  {"application/json"},
+	}
+	return notifier.Send(ctx, nil, http.MethodPost, endpoint, header, bytes.NewReader(data))
+}
@@ -0,0 +1,74 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// pagerDutyEvent is an Events API v2 event.
+//
+// Reference: https://developer.pagerduty.com/docs/events-api-v2/trigger-events/
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+	Client      string            `json:"client,omitempty"`
+	ClientURL   string            `json:"client_url,omitempty"`
+	Links       []pagerDutyLink   `json:"links,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Component     string            `json:"component,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	Class         string            `json:"class,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type pagerDutyLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+const maxPagerDutySummary = 1024
+
+// pagerDutySeverity rates the job statuses that trigger incidents.
+var pagerDutySeverity = map[string]string{
+	"failure":   "error",
+	"timed_out": "error",
+	"cancelled": "warning",
+}
+
+func (r Request) pagerDuty(ctx context.Context, base, routingKey string, act action) error {
+	ev := pagerDutyEvent{
+		RoutingKey:  routingKey,
+		EventAction: "resolve",
+		DedupKey:    r.dedupKey(),
+	}
+	if act == trigger {
+		d := r.Detail
+		ev.EventAction = "trigger"
+		ev.Payload = &pagerDutyPayload{
+			Summary:       markdown.Truncate(markdown.Plain.Convert(d.Summary), maxPagerDutySummary),
+			Source:        d.Repository,
+			Severity:      pagerDutySeverity[d.Status],
+			Component:     d.Workflow,
+			Group:         d.Branch,
+			Class:         d.Status,
+			CustomDetails: r.details(),
+		}
+		ev.Client = source
+		for i, a := range d.Action {
+			if i == 0 {
+				ev.ClientURL = a.URL
+			}
+			ev.Links = append(ev.Links, pagerDutyLink{a.URL, a.Name})
+		}
+	}
+	return notifier.PostJSON(ctx, base+"/v2/enqueue", ev)
+}
@@ -0,0 +1,147 @@
+package discord
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// Embed limits, counted in characters; Discord rejects larger embeds.
+//
+// Reference: https://discord.com/developers/docs/resources/channel#embed-limits
+const (
+	maxTitle       = 256
+	maxDescription = 4096
+	maxFields      = 25
+	maxFieldName   = 256
+	maxFieldValue  = 1024
+	maxAuthor      = 256
+	maxTotal       = 6000
+)
+
+type Request struct {
+	Content string  `json:"content,omitempty"`
+	Embeds  []Embed `json:"embeds"`
+}
+
+type Embed struct {
+	Author      *Author `json:"author,omitempty"`
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	URL         string  `json:"url,omitempty"`
+	Color       int     `json:"color,omitempty"`
+	Fields      []Field `json:"fields,omitempty"`
+}
+
+type Author struct {
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url,omitempty"`
+}
+
+type Field struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+func (r Request) Submit(ctx context.Context, url string) error {
+	return notifier.PostJSON(ctx, url, r)
+}
+
+func BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return Build(d)
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	embed := Embed{
+		Title: markdown.Truncate(d.Title, maxTitle),
+		Color: color(d.ThemeColor),
+	}
+	if embed.Title == "" {
+		embed.Title = markdown.Truncate(d.Repository, maxTitle)
+	}
+	// The author carries the avatar, and Discord requires it to have a name
+	// even when the text already names the user.
+	author := d.Username
+	if author == "" {
+		author = d.Repository
+	}
+	if author != "" || d.Avatar != "" {
+		embed.Author = &Author{Name: nonBlank(markdown.Truncate(author, maxAuthor)), IconURL: d.Avatar}
+	}
+
+	description := []string{d.Text, d.Body}
+	var links []string
+	for i, a := range d.Action {
+		if i == 0 {
+			embed.URL = a.URL
+		} else if a.Name != "" {
+			links = append(links, "["+a.Name+"]("+a.URL+")")
+		}
+	}
+	description = append(description, strings.Join(links, " · "))
+	embed.Description = markdown.Truncate(markdown.Join(description), maxDescription)
+
+	for _, f := range d.Fact {
+		if len(embed.Fields) == maxFields {
+			break
+		}
+		embed.Fields = append(embed.Fields, Field{
+			Name:   markdown.Truncate(nonBlank(f.Name), maxFieldName),
+			Value:  markdown.Truncate(nonBlank(f.Value), maxFieldValue),
+			Inline: true,
+		})
+	}
+	fit(&embed)
+
+	return &Request{Embeds: []Embed{embed}}
+}
+
+// fit keeps the embed within Discord's total character limit, first by
+// dropping fields from the end, then by shortening the description.
+func fit(e *Embed) {
+	size := length(e.Title) + length(e.Description)
+	if e.Author != nil {
+		size += length(e.Author.Name)
+	}
+	for _, f := range e.Fields {
+		size += length(f.Name) + length(f.Value)
+	}
+	for size > maxTotal && len(e.Fields) > 0 {
+		last := e.Fields[len(e.Fields)-1]
+		size -= length(last.Name) + length(last.Value)
+		e.Fields = e.Fields[:len(e.Fields)-1]
+	}
+	if size > maxTotal {
+		e.Description = markdown.Truncate(e.Description, length(e.Description)-(size-maxTotal))
+	}
+}
+
+// color converts a ThemeColor like "#6e5494" to the integer Discord wants.
+func color(theme string) int {
+	c, err := strconv.ParseInt(strings.TrimPrefix(theme, "#"), 16, 32)
+	if err != nil {
+		return 0
+	}
+	return int(c)
+}
+
+// nonBlank stands in a zero width space for empty text, which Discord
+// rejects in fields.
+func nonBlank(s string) string {
+	if s == "" {
+		return "\u200b"
+	}
+	return s
+}
+
+func length(s string) int {
+	return len([]rune(s))
+}
@@ -0,0 +1,49 @@
+package discord
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+func TestLimits(t *testing.T) {
+	d := &event.Detail{
+		Repository: "orgname/reponame",
+		Username:   "username",
+		Text:       strings.Repeat("t", 5000),
+		Action:     []event.Action{{Name: "View", URL: "https://example.net/view"}},
+	}
+	for i := 0; i < 30; i++ {
+		d.Fact = append(d.Fact, event.Fact{Name: "name", Value: strings.Repeat("v", 2000)})
+	}
+
+	e := Build(d).Embeds[0]
+	if len(e.Fields) > maxFields {
+		t.Errorf("got %d fields, want at most %d", len(e.Fields), maxFields)
+	}
+	size := length(e.Title) + length(e.Description) + length(e.Author.Name)
+	for _, f := range e.Fields {
+		if length(f.Value) > maxFieldValue {
+			t.Errorf("got field of %d characters, want at most %d", length(f.Value), maxFieldValue)
+		}
+		size += length(f.Name) + length(f.Value)
+	}
+	if size > maxTotal {
+		t.Errorf("got %d characters, want at most %d", size, maxTotal)
+	}
+	if length(e.Description) != maxDescription {
+		t.Errorf("got description of %d characters, want %d", length(e.Description), maxDescription)
+	}
+	if e.URL != "https://example.net/view" {
+		t.Errorf("got URL %q, want the first action's", e.URL)
+	}
+}
+
+func TestColor(t *testing.T) {
+	for theme, want := range map[string]int{"#6e5494": 0x6e5494, "#2cbe4e": 0x2cbe4e, "": 0, "red": 0} {
+		if got := color(theme); got != want {
+			t.Errorf("color(%q) = %#x, want %#x", theme, got, want)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package email
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io/ioutil"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+// fakeServer speaks just enough SMTP to accept one message per connection.
+type fakeServer struct {
+	ln       net.Listener
+	tls      *tls.Config // nil to not offer STARTTLS
+	mu       sync.Mutex
+	secure   bool
+	auth     string
+	from     string
+	to       []string
+	data     []byte
+	finished chan struct{}
+}
+
+func newFakeServer(t *testing.T, config *tls.Config) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeServer{ln: ln, tls: config, finished: make(chan struct{})}
+	go s.serve(t)
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *fakeServer) serve(t *testing.T) {
+	defer close(s.finished)
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 fake ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			t.Errorf("server: %v", err)
+			return
+		}
+		verb := strings.ToUpper(strings.Fields(line + " ")[0])
+		arg := strings.TrimSpace(line[len(verb):])
+		s.mu.Lock()
+		switch verb {
+		case "EHLO":
+			tp.PrintfLine("250-fake")
+			if s.tls != nil && !s.secure {
+				tp.PrintfLine("250-STARTTLS")
+			}
+			tp.PrintfLine("250 AUTH PLAIN")
+		case "STARTTLS":
+			tp.PrintfLine("220 ready")
+			conn = tls.Server(conn, s.tls)
+			tp = textproto.NewConn(conn)
+			s.secure = true
+		case "AUTH":
+			auth, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(arg, "PLAIN "))
+			s.auth = string(auth)
+			tp.PrintfLine("235 ok")
+		case "MAIL":
+			s.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			tp.PrintfLine("250 ok")
+		case "RCPT":
+			s.to = append(s.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			tp.PrintfLine("250 ok")
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			s.data, err = tp.ReadDotBytes()
+			if err != nil {
+				t.Errorf("server: %v", err)
+			}
+			tp.PrintfLine("250 ok")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			s.mu.Unlock()
+			return
+		default:
+			tp.PrintfLine("502 unknown command")
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *fakeServer) url(userinfo, query string) string {
+	if userinfo != "" {
+		userinfo += "@"
+	}
+	return "smtp://" + userinfo + s.ln.Addr().String() + "?" + query
+}
+
+// selfSigned returns server and client configurations sharing a certificate
+// for 127.0.0.1.
+func selfSigned(t *testing.T) (server, client *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}},
+		&tls.Config{RootCAs: pool}
+}
+
+var detail = &event.Detail{
+	Summary:    "username pushed dev",
+	ThemeColor: "#6e5494",
+	Repository: "orgname/reponame",
+	Username:   "username",
+	Text:       "**username** pushed 1 commit to **dev** — «now»",
+	Fact:       []event.Fact{{Name: "090e4f202", Value: `**Adjust table\_row\_count** [🔍](https://github.com/orgname/reponame/commit/090e4f202)`}},
+	Action:     []event.Action{{Name: "View", URL: "https://github.com/orgname/reponame?a=1&b=2"}},
+}
+
+func TestSubmit(t *testing.T) {
+	serverTLS, clientTLS := selfSigned(t)
+	defer func(orig func(string) *tls.Config) { tlsConfig = orig }(tlsConfig)
+	tlsConfig = func(host string) *tls.Config {
+		c := clientTLS.Clone()
+		c.ServerName = host
+		return c
+	}
+
+	s := newFakeServer(t, serverTLS)
+	err := Build(detail).Submit(context.Background(), s.url("user:secret", "from=ci@example.com&to=a@example.com,b@example.com&to=c@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-s.finished
+
+	if !s.secure {
+		t.Error("did not STARTTLS")
+	}
+	if s.auth != "\x00user\x00secret" {
+		t.Errorf("auth: got %q", s.auth)
+	}
+	if s.from != "ci@example.com" {
+		t.Errorf("from: got %q", s.from)
+	}
+	if got := strings.Join(s.to, " "); got != "a@example.com b@example.com c@example.com" {
+		t.Errorf("to: got %q", got)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(s.data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "[orgname/reponame] username pushed dev" {
+		t.Errorf("subject: got %q, %v", subject, err)
+	}
+	if got := msg.Header.Get("To"); got != "a@example.com, b@example.com, c@example.com" {
+		t.Errorf("To: got %q", got)
+	}
+	media, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || media != "multipart/alternative" {
+		t.Fatalf("content type: got %q, %v", media, err)
+	}
+
+	want := []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", "username pushed 1 commit to dev — «now»\n\n090e4f202: Adjust table_row_count 🔍 (https://github.com/orgname/reponame/commit/090e4f202)\n\nView: https://github.com/orgname/reponame?a=1&b=2\n"},
+		{"text/html; charset=utf-8", `<tr><td><b>username</b> pushed 1 commit to <b>dev</b> — «now»</td></tr>`},
+	}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for _, w := range want {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := part.Header.Get("Content-Type"); got != w.contentType {
+			t.Errorf("part content type: got %q, want %q", got, w.contentType)
+		}
+		content, err := ioutil.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), w.content) {
+			t.Errorf("%s part:\n%s\nwant it to contain\n%s", w.contentType, content, w.content)
+		}
+	}
+	if _, err := parts.NextPart(); err == nil {
+		t.Error("extra part")
+	}
+}
+
+func TestSubmitPlain(t *testing.T) {
+	s := newFakeServer(t, nil)
+	if err := Build(detail).Submit(context.Background(), s.url("", "from=ci@example.com&to=a@example.com")); err != nil {
+		t.Fatal(err)
+	}
+	<-s.finished
+	if s.secure || s.auth != "" {
+		t.Errorf("secure %v, auth %q: want neither", s.secure, s.auth)
+	}
+}
+
+func TestSubmitErrors(t *testing.T) {
+	for _, url := range []string{
+		"https://example.com/hook",
+		"smtp://smtp.example.com?to=a@example.com",
+		"smtp://smtp.example.com?from=ci@example.com&to=,",
+	} {
+		if err := Build(detail).Submit(context.Background(), url); err == nil {
+			t.Errorf("Submit(%q): want error", url)
+		}
+	}
+}
@@ -13,6 +13,13 @@ type Detail struct {
 	Username   string
 	Avatar     string
 	Title      string // avoid - it's huge
+	Status     string // job status being reported, like success or failure
+
+	// For a job status on a branch, where the workflow ran and the
+	// repository's default branch.
+	Workflow      string
+	Branch        string
+	DefaultBranch string
 
 	Text string
 	Body string
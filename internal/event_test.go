@@ -3,14 +3,24 @@ package main_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/MichaelUrman/notify/internal/discord"
 	"github.com/MichaelUrman/notify/internal/event"
 	"github.com/MichaelUrman/notify/internal/github"
+	"github.com/MichaelUrman/notify/internal/googlechat"
+	"github.com/MichaelUrman/notify/internal/mattermost"
+	"github.com/MichaelUrman/notify/internal/rocketchat"
+	"github.com/MichaelUrman/notify/internal/slack"
 	"github.com/MichaelUrman/notify/internal/teams"
 	"github.com/google/go-cmp/cmp"
 )
@@ -19,6 +29,47 @@ func TestGithub(t *testing.T) {
 	testLoader(t, ".github.json", loadGithub)
 }
 
+func TestSkip(t *testing.T) {
+	for _, input := range []string{
+		"testdata/workflow_dispatch/inputs.github.json",
+		"testdata/schedule/schedule.github.json",
+		"testdata/repository_dispatch/deploy.github.json",
+		"testdata/gollum/edited.github.json",
+	} {
+		detail, err := github.LoadTestEvent(context.Background(), github.TestEnv{
+			EventName: filepath.Base(filepath.Dir(input)),
+			EventPath: input,
+			Triggers:  "skip",
+			Unknown:   "skip",
+		})
+		if err != nil || detail != nil {
+			t.Errorf("%s: got %v, %v; want no message", input, detail, err)
+		}
+	}
+}
+
+func TestJobStatusWithoutRef(t *testing.T) {
+	f, err := ioutil.TempFile("", "payload*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Remove(f.Name()) })
+	f.WriteString(`{"head_commit": {"id": "abc", "url": "https://github.com/o/r/commit/abc"}, "merge_group": {"head_sha": "abc"}}`)
+	f.Close()
+
+	for _, name := range []string{"workflow_dispatch", "schedule", "repository_dispatch", "merge_group"} {
+		detail, err := github.LoadTestEvent(context.Background(), github.TestEnv{
+			WorkflowName: "WorkflowName",
+			JobStatus:    "success",
+			EventName:    name,
+			EventPath:    f.Name(),
+		})
+		if err != nil || detail == nil {
+			t.Errorf("%s: got %v, %v; want a message", name, detail, err)
+		}
+	}
+}
+
 func testLoader(t *testing.T, suffix string, loader func(*testing.T, string) *event.Detail) {
 	if err := filepath.Walk("testdata", func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -32,6 +83,12 @@ func testLoader(t *testing.T, suffix string, loader func(*testing.T, string) *ev
 				output := func(input, newSuffix string) string { return strings.ReplaceAll(input, suffix, newSuffix) }
 				t.Run("event", func(t *testing.T) { testEvent(t, detail, path, output(path, ".event.json")) })
 				t.Run("teams", func(t *testing.T) { testTeams(t, detail, path, output(path, ".teams.json")) })
+				t.Run("adaptive", func(t *testing.T) { testAdaptive(t, detail, path, output(path, ".adaptive.json")) })
+				t.Run("slack", func(t *testing.T) { testSlack(t, detail, path, output(path, ".slack.json")) })
+				t.Run("discord", func(t *testing.T) { testDiscord(t, detail, path, output(path, ".discord.json")) })
+				t.Run("googlechat", func(t *testing.T) { testGoogleChat(t, detail, path, output(path, ".googlechat.json")) })
+				t.Run("mattermost", func(t *testing.T) { testMattermost(t, detail, path, output(path, ".mattermost.json")) })
+				t.Run("rocketchat", func(t *testing.T) { testRocketChat(t, detail, path, output(path, ".rocketchat.json")) })
 			}
 		})
 
@@ -42,9 +99,12 @@ func testLoader(t *testing.T, suffix string, loader func(*testing.T, string) *ev
 }
 
 func loadGithub(t *testing.T, input string) *event.Detail {
+	token, apiURL := testAPI(t, input)
 	detail, err := github.LoadTestEvent(context.Background(), github.TestEnv{
 		EventName: filepath.Base(filepath.Dir(input)),
 		EventPath: input,
+		Token:     token,
+		APIURL:    apiURL,
 	})
 	if err != nil {
 		t.Fatal("loading payload", err)
@@ -52,6 +112,46 @@ func loadGithub(t *testing.T, input string) *event.Detail {
 	return detail
 }
 
+// testAPI serves the REST API responses in input's .api.json, a map of request
+// path to response body, and returns a token and URL for reaching them. Inputs
+// without an .api.json get no token, so they see no API at all.
+func testAPI(t *testing.T, input string) (token, url string) {
+	apiPath := strings.ReplaceAll(input, ".github.json", ".api.json")
+	f, err := os.Open(apiPath)
+	if os.IsNotExist(err) {
+		return "", ""
+	} else if err != nil {
+		t.Fatal("reading api responses:", err)
+	}
+	defer f.Close()
+
+	var responses map[string]json.RawMessage
+	if err := json.NewDecoder(f).Decode(&responses); err != nil {
+		t.Fatal("decoding api responses:", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		page := 1
+		if n, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil {
+			path += "?page=" + r.URL.Query().Get("page")
+			page = n
+		}
+		resp, ok := responses[path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if next := fmt.Sprintf("%s?page=%d", r.URL.Path, page+1); responses[next] != nil {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s%s>; rel="next"`, r.Host, next))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return "test-token", srv.URL
+}
+
 func decode(t *testing.T, output string, data interface{}) {
 	want, err := os.Open(output)
 	if err != nil {
@@ -95,6 +195,84 @@ func testTeams(t *testing.T, detail *event.Detail, input, output string) {
 	compare(t, input, cases, func(detail *event.Detail) interface{} { return teams.Build(detail) })
 }
 
+func testAdaptive(t *testing.T, detail *event.Detail, input, output string) {
+	cases := struct {
+		Want   *teams.AdaptiveRequest `json:"WORKFLOW"`
+		Pass   *teams.AdaptiveRequest `json:"PASSED" status:"success"`
+		Fail   *teams.AdaptiveRequest `json:"FAILED" status:"failure"`
+		Cancel *teams.AdaptiveRequest `json:"CANCEL" status:"cancelled"`
+		Skip   *teams.AdaptiveRequest `json:"SKIPPED" status:"skipped"`
+	}{}
+
+	decode(t, output, &cases)
+	compare(t, input, cases, func(detail *event.Detail) interface{} { return teams.BuildAdaptive(detail) })
+}
+
+func testSlack(t *testing.T, detail *event.Detail, input, output string) {
+	cases := struct {
+		Want   *slack.Request `json:"WORKFLOW"`
+		Pass   *slack.Request `json:"PASSED" status:"success"`
+		Fail   *slack.Request `json:"FAILED" status:"failure"`
+		Cancel *slack.Request `json:"CANCEL" status:"cancelled"`
+		Skip   *slack.Request `json:"SKIPPED" status:"skipped"`
+	}{}
+
+	decode(t, output, &cases)
+	compare(t, input, cases, func(detail *event.Detail) interface{} { return slack.Build(detail) })
+}
+
+func testDiscord(t *testing.T, detail *event.Detail, input, output string) {
+	cases := struct {
+		Want   *discord.Request `json:"WORKFLOW"`
+		Pass   *discord.Request `json:"PASSED" status:"success"`
+		Fail   *discord.Request `json:"FAILED" status:"failure"`
+		Cancel *discord.Request `json:"CANCEL" status:"cancelled"`
+		Skip   *discord.Request `json:"SKIPPED" status:"skipped"`
+	}{}
+
+	decode(t, output, &cases)
+	compare(t, input, cases, func(detail *event.Detail) interface{} { return discord.Build(detail) })
+}
+
+func testGoogleChat(t *testing.T, detail *event.Detail, input, output string) {
+	cases := struct {
+		Want   *googlechat.Request `json:"WORKFLOW"`
+		Pass   *googlechat.Request `json:"PASSED" status:"success"`
+		Fail   *googlechat.Request `json:"FAILED" status:"failure"`
+		Cancel *googlechat.Request `json:"CANCEL" status:"cancelled"`
+		Skip   *googlechat.Request `json:"SKIPPED" status:"skipped"`
+	}{}
+
+	decode(t, output, &cases)
+	compare(t, input, cases, func(detail *event.Detail) interface{} { return googlechat.Build(detail) })
+}
+
+func testMattermost(t *testing.T, detail *event.Detail, input, output string) {
+	cases := struct {
+		Want   *mattermost.Request `json:"WORKFLOW"`
+		Pass   *mattermost.Request `json:"PASSED" status:"success"`
+		Fail   *mattermost.Request `json:"FAILED" status:"failure"`
+		Cancel *mattermost.Request `json:"CANCEL" status:"cancelled"`
+		Skip   *mattermost.Request `json:"SKIPPED" status:"skipped"`
+	}{}
+
+	decode(t, output, &cases)
+	compare(t, input, cases, func(detail *event.Detail) interface{} { return mattermost.Build(detail) })
+}
+
+func testRocketChat(t *testing.T, detail *event.Detail, input, output string) {
+	cases := struct {
+		Want   *rocketchat.Request `json:"WORKFLOW"`
+		Pass   *rocketchat.Request `json:"PASSED" status:"success"`
+		Fail   *rocketchat.Request `json:"FAILED" status:"failure"`
+		Cancel *rocketchat.Request `json:"CANCEL" status:"cancelled"`
+		Skip   *rocketchat.Request `json:"SKIPPED" status:"skipped"`
+	}{}
+
+	decode(t, output, &cases)
+	compare(t, input, cases, func(detail *event.Detail) interface{} { return rocketchat.Build(detail) })
+}
+
 func compare(t *testing.T, input string, cases interface{}, build func(*event.Detail) interface{}) {
 	v := reflect.ValueOf(cases)
 	for i := 0; i < v.NumField(); i++ {
@@ -102,12 +280,15 @@ func compare(t *testing.T, input string, cases interface{}, build func(*event.De
 		if !want.IsZero() {
 			status := v.Type().Field(i).Tag.Get("status")
 			t.Run(status, func(t *testing.T) {
+				token, apiURL := testAPI(t, input)
 				detail, err := github.LoadTestEvent(context.Background(), github.TestEnv{
 					WorkflowName: "WorkflowName",
 					JobStatus:    status,
 					RunID:        "12345",
 					EventName:    filepath.Base(filepath.Dir(input)),
 					EventPath:    input,
+					Token:        token,
+					APIURL:       apiURL,
 				})
 				if err != nil {
 					t.Fatal("loading status payload", err)
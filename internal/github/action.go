@@ -29,7 +29,7 @@ func (env) Mask(value string)                        { println("::add-mask::" +
 func (env) Group(name string)                        { println("::group::" + name) }
 func (env) EndGroup()                                { println("::endgroup::\n") }
 func (env) Debugf(format string, a ...interface{})   { println("::debug::" + fmt.Sprintf(format, a...)) }
-func (env) Warnf(format string, a ...interface{})    { println("::warn::" + fmt.Sprintf(format, a...)) }
+func (env) Warnf(format string, a ...interface{})    { println("::warning::" + fmt.Sprintf(format, a...)) }
 func (env) Errorf(format string, a ...interface{})   { println("::error::" + fmt.Sprintf(format, a...)) }
 func (e env) Fatalf(format string, a ...interface{}) { e.Errorf(format, a...); os.Exit(1) }
 
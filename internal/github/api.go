@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const tokenInput = "github-token"
+
+// expander is implemented by eventers whose webhook payload leaves out
+// details that the REST API can fill in.
+type expander interface {
+	expand(context.Context, *client) error
+}
+
+type client struct {
+	url   string
+	token string
+}
+
+// apiClient returns a client for the REST API, or nil if no token was given.
+func apiClient() *client {
+	token := Actions.Secret(tokenInput)
+	if token == "" {
+		return nil
+	}
+	url := os.Getenv("GITHUB_API_URL")
+	if url == "" {
+		url = "https://api.github.com"
+	}
+	return &client{url: strings.TrimSuffix(url, "/"), token: token}
+}
+
+func (c *client) get(ctx context.Context, path string, data interface{}) error {
+	_, err := c.fetch(ctx, c.url+path, path, data)
+	return err
+}
+
+// list decodes every page of the list at path into data in turn, following
+// the Link headers, and calls page after each one to collect its items.
+func (c *client) list(ctx context.Context, path string, data interface{}, page func()) error {
+	url := c.url + path
+	for url != "" {
+		var err error
+		if url, err = c.fetch(ctx, url, path, data); err != nil {
+			return err
+		}
+		page()
+	}
+	return nil
+}
+
+// fetch decodes the response from url into data and returns the URL of the
+// next page, if there is one.
+func (c *client) fetch(ctx context.Context, url, path string, data interface{}) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Add("Accept", "application/vnd.github.v3+json")
+	req.Header.Add("Authorization", "token "+c.token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		response, _ := ioutil.ReadAll(resp.Body)
+		return "", fmt.Errorf("requesting %s (%v): %s", path, resp.StatusCode, response)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(data); err != nil {
+		return "", fmt.Errorf("decoding %s: %w", path, err)
+	}
+	return nextPage(resp.Header.Get("Link")), nil
+}
+
+// nextPage returns the rel="next" URL from a Link header.
+func nextPage(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		fields := strings.Split(part, ";")
+		for _, param := range fields[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(fields[0]), "<>")
+			}
+		}
+	}
+	return ""
+}
+
+// expand fills in e from the REST API when it needs it and a token is
+// available. Failures are only logged; the payload alone still makes a message.
+func expand(ctx context.Context, e eventer) {
+	x, ok := e.(expander)
+	if !ok {
+		return
+	}
+	api := apiClient()
+	if api == nil {
+		return
+	}
+	if err := x.expand(ctx, api); err != nil {
+		Actions.Debugf("expanding event: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package github
+
+import (
+	"golang.org/x/text/message"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+const unknownInput = "unknown-events"
+
+// handlers maps each webhook event name to a constructor for the eventer
+// that decodes its payload. Events without one get an Unknown.
+var handlers = map[string]func() eventer{}
+
+// register adds the eventer for an event name; call it from init.
+func register(event string, handler func() eventer) {
+	if _, dup := handlers[event]; dup {
+		panic("github: event registered twice: " + event)
+	}
+	handlers[event] = handler
+}
+
+func handled(event string) bool {
+	_, ok := handlers[event]
+	return ok
+}
+
+// newEventer returns an empty eventer for decoding event's payload.
+func newEventer(event string) eventer {
+	if handler, ok := handlers[event]; ok {
+		return handler()
+	}
+	return &Unknown{event: event}
+}
+
+// Unknown reports any event we have no better words for, with just enough to
+// say what happened and where.
+type Unknown struct {
+	Common
+	event string
+}
+
+func (ev Unknown) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	name := md(ev.event)
+
+	var facts []event.Fact
+	if ev.Action != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(unknownAction), Value: p.Sprintf("%#s", md(ev.Action))})
+	}
+	repository := ev.Repository.FullName
+	if repository == "" {
+		repository = ev.Organization.Login
+	}
+	var view []event.Action
+	if ev.Repository.URL != "" {
+		view = []event.Action{{URL: ev.Repository.URL}}
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Repository: repository,
+		Summary:    p.Sprintf(message.Key(msgUnknownSummary, "%s triggered %s"), username, name),
+		Text:       p.Sprintf(msgUserUnknownEvent, username, name),
+		Fact:       facts,
+		Action:     view,
+	})
+}
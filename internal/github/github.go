@@ -1,18 +1,55 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/text/message"
 
 	"github.com/MichaelUrman/notify/internal/event"
 )
 
+func init() {
+	register("branch_protection_rule", func() eventer { return &BranchProtectionRule{} })
+	register("check_run", func() eventer { return &CheckRun{} })
+	register("check_suite", func() eventer { return &CheckSuite{} })
+	register("create", func() eventer { return &Create{} })
+	register("delete", func() eventer { return &Delete{} })
+	register("deployment", func() eventer { return &DeploymentEvent{} })
+	register("deployment_status", func() eventer { return &DeploymentStatus{} })
+	register("discussion", func() eventer { return &DiscussionEvent{} })
+	register("discussion_comment", func() eventer { return &DiscussionComment{} })
+	register("fork", func() eventer { return &Fork{} })
+	register("issue_comment", func() eventer { return &IssueComment{} })
+	register("issues", func() eventer { return &Issues{} })
+	register("member", func() eventer { return &Member{} })
+	register("merge_group", func() eventer { return &MergeGroup{} })
+	register("public", func() eventer { return &Public{} })
+	register("pull_request", func() eventer { return &PullRequest{} })
+	register("pull_request_review", func() eventer { return &PullRequestReview{} })
+	register("pull_request_review_comment", func() eventer { return &PullRequestReviewComment{} })
+	register("push", func() eventer { return &Push{} })
+	register("release", func() eventer { return &Release{} })
+	register("repository", func() eventer { return &Repository{} })
+	register("repository_dispatch", func() eventer { return &Trigger{kind: "repository_dispatch"} })
+	register("schedule", func() eventer { return &Trigger{kind: "schedule"} })
+	register("star", func() eventer { return &Star{} })
+	register("status", func() eventer { return &Status{} })
+	register("workflow_dispatch", func() eventer { return &Trigger{kind: "workflow_dispatch"} })
+	register("workflow_job", func() eventer { return &WorkflowJob{} })
+	register("workflow_run", func() eventer { return &WorkflowRun{} })
+	register("_job_status", func() eventer { return &JobStatus{} })
+}
+
 func LoadEvent(ctx context.Context) (*event.Detail, error) {
 	lang := message.MatchLanguage(Actions.Input("lang"), "en")
 	pr := message.NewPrinter(lang)
@@ -21,6 +58,13 @@ func LoadEvent(ctx context.Context) (*event.Detail, error) {
 	if status != "" {
 		return ReportWorkflowStatus(ctx, pr, status)
 	}
+	if event := os.Getenv("GITHUB_EVENT_NAME"); isTrigger(event) && Actions.Input(triggersInput) == "skip" {
+		Actions.Debugf("skipping %s event", event)
+		return nil, nil
+	} else if !handled(event) && Actions.Input(unknownInput) == "skip" {
+		Actions.Debugf("skipping unknown %s event", event)
+		return nil, nil
+	}
 	return ParseWorkflow(ctx, pr)
 }
 
@@ -31,6 +75,10 @@ type TestEnv struct {
 	EventPath    string
 	JobStatus    string
 	Lang         string
+	Token        string
+	APIURL       string
+	Triggers     string
+	Unknown      string
 }
 
 func LoadTestEvent(ctx context.Context, env TestEnv) (*event.Detail, error) {
@@ -41,6 +89,10 @@ func LoadTestEvent(ctx context.Context, env TestEnv) (*event.Detail, error) {
 	os.Setenv("GITHUB_EVENT_PATH", env.EventPath)
 	os.Setenv("INPUT_JOB-STATUS", env.JobStatus)
 	os.Setenv("INPUT_LANG", env.Lang)
+	os.Setenv("INPUT_GITHUB-TOKEN", env.Token)
+	os.Setenv("GITHUB_API_URL", env.APIURL)
+	os.Setenv("INPUT_TRIGGERS", env.Triggers)
+	os.Setenv("INPUT_UNKNOWN-EVENTS", env.Unknown)
 
 	return LoadEvent(ctx)
 }
@@ -116,6 +168,96 @@ func (ev CheckRun) Event(p *message.Printer) *event.Detail {
 	return nil
 }
 
+type CheckSuite struct {
+	Common
+	CheckSuite struct {
+		ID         int
+		HeadBranch string `json:"head_branch"`
+		HeadSHA    string `json:"head_sha"`
+		Conclusion string
+		App        struct {
+			Name      string
+			AvatarURL string `json:"avatar_url"`
+		}
+	} `json:"check_suite"`
+	runs []checkResult
+}
+
+func (ev *CheckSuite) expand(ctx context.Context, api *client) error {
+	var resp struct {
+		CheckRuns []struct {
+			Name       string
+			Status     string
+			Conclusion string
+			URL        string `json:"html_url"`
+		} `json:"check_runs"`
+	}
+	path := fmt.Sprintf("/repos/%s/check-suites/%d/check-runs?per_page=100", ev.Repository.FullName, ev.CheckSuite.ID)
+	return api.list(ctx, path, &resp, func() {
+		for _, run := range resp.CheckRuns {
+			status := "pending"
+			if run.Status == "completed" {
+				status = jobConclusion(run.Conclusion)
+			}
+			ev.runs = append(ev.runs, checkResult{run.Name, status, run.URL})
+		}
+		resp.CheckRuns = nil
+	})
+}
+
+func (ev *CheckSuite) Event(p *message.Printer) *event.Detail {
+	if ev.Action != "completed" {
+		return nil
+	}
+	suite := ev.CheckSuite
+	return checksEvent(p, ev.Common, suite.App.Name, suite.App.AvatarURL, jobConclusion(suite.Conclusion), suite.HeadBranch, suite.HeadSHA, ev.runs)
+}
+
+// checkResult is one check in a check_suite or combined status.
+type checkResult struct {
+	name, status, url string
+}
+
+// checksEvent reports the result of several checks on a commit. When all of
+// them passed it's a single line; otherwise each check gets a fact.
+func checksEvent(p *message.Printer, c Common, name, avatar, status, headBranch, headSHA string, checks []checkResult) *event.Detail {
+	jobName := md(name)
+	jobStatus := status + "||job"
+	symbol := status + "||job|sym"
+
+	refOrSha := md(branch(headBranch))
+	if refOrSha == "" && len(headSHA) >= 9 {
+		refOrSha = md(headSHA[:9])
+	}
+
+	detail := event.Detail{
+		Username: name,
+		Avatar:   avatar,
+		Summary:  p.Sprintf(message.Key(msgWorkflowStatusSummary, "%s %m for %s"), jobName, jobStatus, refOrSha),
+		Text:     p.Sprintf(message.Key(msgWorkflowStatus, "%m %#s %m for %#+s"), symbol, jobName, jobStatus, refOrSha),
+	}
+
+	green := status == "success"
+	for _, check := range checks {
+		green = green && (check.status == "success" || check.status == "skipped")
+	}
+	if green {
+		return fillEvent(p, c, detail)
+	}
+
+	for _, check := range checks {
+		value := p.Sprintf(msgCheckResult, check.status+"||job|sym", check.status+"||job")
+		if check.url != "" {
+			value = fmt.Sprintf("[%s](%s)", value, check.url)
+		}
+		detail.Fact = append(detail.Fact, event.Fact{Name: p.Sprintf("%#s", md(check.name)), Value: value})
+	}
+	if headSHA != "" {
+		detail.Action = []event.Action{{Name: p.Sprint(viewChecks), URL: fmt.Sprintf("%s/commit/%s", c.Repository.URL, headSHA)}}
+	}
+	return fillEvent(p, c, detail)
+}
+
 type Create struct {
 	Common
 	RefType string `json:"ref_type"`
@@ -160,6 +302,490 @@ func (ev Delete) Event(p *message.Printer) *event.Detail {
 	return nil
 }
 
+type Public struct {
+	Common
+}
+
+func (ev Public) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	repo := md(ev.Repository.FullName)
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedRepoSummary, "%s %m %s"), username, repoPublicized+"|summary", repo),
+		Text:    p.Sprintf(msgUserVerbedRepo, username, repoPublicized, repo),
+		Action:  []event.Action{{URL: ev.Repository.URL}},
+	})
+}
+
+type Member struct {
+	Common
+	Member struct {
+		Login string
+		URL   string `json:"html_url"`
+	}
+	Changes struct {
+		Permission struct {
+			To string
+		}
+	}
+}
+
+func (ev Member) Event(p *message.Printer) *event.Detail {
+	switch ev.Action {
+	case "added", "removed", "edited":
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	member := md(ev.Member.Login)
+	verb := ev.Action + "||member"
+	var facts []event.Fact
+	if permission := ev.Changes.Permission.To; permission != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(memberPermission), Value: p.Sprintf("%#s", md(permission))})
+	}
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedMemberSummary, "%s %m %s"), username, verb+"|summary", member),
+		Text:    p.Sprintf(msgUserVerbedMember, username, verb, member),
+		Fact:    facts,
+		Action:  []event.Action{{URL: ev.Member.URL}},
+	})
+}
+
+type Repository struct {
+	Common
+	Changes struct {
+		Repository struct {
+			Name struct{ From string }
+		}
+		Owner struct {
+			From struct {
+				User         struct{ Login string }
+				Organization struct{ Login string }
+			}
+		}
+	}
+}
+
+func (ev Repository) Event(p *message.Printer) *event.Detail {
+	var facts []event.Fact
+	switch ev.Action {
+	case "created", "deleted", "archived", "unarchived", "publicized", "privatized":
+	case "renamed":
+		facts = append(facts, event.Fact{Name: p.Sprint(repoPreviousName), Value: p.Sprintf("%#s", md(ev.Changes.Repository.Name.From))})
+	case "transferred":
+		from := ev.Changes.Owner.From.Organization.Login
+		if from == "" {
+			from = ev.Changes.Owner.From.User.Login
+		}
+		facts = append(facts, event.Fact{Name: p.Sprint(repoPreviousOwner), Value: p.Sprintf("%#s", md(from))})
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	repo := md(ev.Repository.FullName)
+	verb := ev.Action + "||repository"
+	var view []event.Action
+	if ev.Action != "deleted" {
+		view = []event.Action{{URL: ev.Repository.URL}}
+	}
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedRepoSummary, "%s %m %s"), username, verb+"|summary", repo),
+		Text:    p.Sprintf(msgUserVerbedRepo, username, verb, repo),
+		Fact:    facts,
+		Action:  view,
+	})
+}
+
+type BranchProtectionRule struct {
+	Common
+	Rule struct {
+		Name string
+	}
+	Changes map[string]json.RawMessage
+}
+
+func (ev BranchProtectionRule) Event(p *message.Printer) *event.Detail {
+	switch ev.Action {
+	case "created", "edited", "deleted":
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	pattern := md(ev.Rule.Name)
+	verb := ev.Action + "||branch_protection"
+	var facts []event.Fact
+	if len(ev.Changes) > 0 {
+		var changed []string
+		for setting := range ev.Changes {
+			changed = append(changed, setting)
+		}
+		sort.Strings(changed)
+		facts = append(facts, event.Fact{Name: p.Sprint(protectionChanged), Value: p.Sprintf("%#s", md(strings.Join(changed, ", ")))})
+	}
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedProtectionSummary, "%s %m %s"), username, verb+"|summary", pattern),
+		Text:    p.Sprintf(msgUserVerbedProtection, username, verb, pattern),
+		Fact:    facts,
+		Action:  []event.Action{{URL: ev.Repository.URL + "/settings/branches"}},
+	})
+}
+
+type Fork struct {
+	Common
+	Forkee struct {
+		FullName string `json:"full_name"`
+		URL      string `json:"html_url"`
+	}
+}
+
+func (ev Fork) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	fork := md(ev.Forkee.FullName)
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgForkedSummary, "%s forked %s"), username, fork),
+		Text:    p.Sprintf(msgUserForked, username, md(ev.Repository.FullName), fork),
+		Action:  []event.Action{{URL: ev.Forkee.URL}},
+	})
+}
+
+type Star struct {
+	Common
+}
+
+func (ev Star) Event(p *message.Printer) *event.Detail {
+	switch ev.Action {
+	case "created", "deleted":
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	repo := md(ev.Repository.FullName)
+	verb := ev.Action + "||star"
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedRepoSummary, "%s %m %s"), username, verb+"|summary", repo),
+		Text:    p.Sprintf(msgUserVerbedRepo, username, verb, repo),
+	})
+}
+
+type Deployment struct {
+	ID          int
+	SHA         string
+	Ref         string
+	Environment string
+	Description string
+	Creator     struct {
+		Login string
+	}
+}
+
+type DeploymentEvent struct {
+	Common
+	Deployment Deployment
+}
+
+func (ev DeploymentEvent) Event(p *message.Printer) *event.Detail {
+	if ev.Action != "created" {
+		return nil
+	}
+	username := md(ev.Sender.Login)
+	ref := md(deploymentRef(ev.Deployment))
+	environment := md(ev.Deployment.Environment)
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgDeployingSummary, "%s deploying %s to %s"), username, ref, environment),
+		Text:    p.Sprintf(msgUserCreatedDeployment, username, ref, environment),
+		Body:    ev.Deployment.Description,
+		Fact:    deploymentFacts(p, ev.Common, ev.Deployment),
+	})
+}
+
+type DeploymentStatus struct {
+	Common
+	Deployment       Deployment
+	DeploymentStatus struct {
+		State          string
+		Description    string
+		Environment    string
+		EnvironmentURL string `json:"environment_url"`
+		LogURL         string `json:"log_url"`
+		TargetURL      string `json:"target_url"`
+	} `json:"deployment_status"`
+}
+
+func (ev DeploymentStatus) Event(p *message.Printer) *event.Detail {
+	if ev.Action != "created" {
+		return nil
+	}
+	status := ev.DeploymentStatus
+	ref := md(deploymentRef(ev.Deployment))
+	environment := status.Environment
+	if environment == "" {
+		environment = ev.Deployment.Environment
+	}
+	state := status.State + "||deployment"
+
+	var view []event.Action
+	if status.EnvironmentURL != "" {
+		view = append(view, event.Action{Name: p.Sprint(viewEnv), URL: status.EnvironmentURL})
+	}
+	logURL := status.LogURL
+	if logURL == "" {
+		logURL = status.TargetURL
+	}
+	if logURL != "" {
+		view = append(view, event.Action{Name: p.Sprint(viewLogs), URL: logURL})
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		ThemeColor: deploymentColor(status.State),
+		Summary:    p.Sprintf(message.Key(msgDeploymentStatusSummary, "%s to %s %m"), ref, md(environment), state),
+		Text:       p.Sprintf(msgDeploymentStatus, state+"|sym", ref, md(environment), state),
+		Body:       status.Description,
+		Fact:       deploymentFacts(p, ev.Common, ev.Deployment),
+		Action:     view,
+	})
+}
+
+// deploymentRef is the ref a deployment was made from, or its commit if the
+// ref is just the sha.
+func deploymentRef(d Deployment) string {
+	if d.Ref == "" || d.Ref == d.SHA {
+		if len(d.SHA) >= 9 {
+			return d.SHA[:9]
+		}
+		return d.SHA
+	}
+	return tag(branch(d.Ref))
+}
+
+func deploymentFacts(p *message.Printer, c Common, d Deployment) []event.Fact {
+	var facts []event.Fact
+	if len(d.SHA) >= 9 {
+		facts = append(facts, event.Fact{Name: p.Sprint(deploymentCommit), Value: fmt.Sprintf("[%s](%s/commit/%s)", d.SHA[:9], c.Repository.URL, d.SHA)})
+	}
+	if d.Creator.Login != "" && d.Creator.Login != c.Sender.Login {
+		facts = append(facts, event.Fact{Name: p.Sprint(deploymentCreator), Value: p.Sprintf("%#+s", md(d.Creator.Login))})
+	}
+	return facts
+}
+
+func deploymentColor(state string) string {
+	switch state {
+	case "success":
+		return themeSuccess
+	case "failure", "error":
+		return themeFailure
+	case "queued", "pending", "in_progress":
+		return themePending
+	case "inactive":
+		return themeInactive
+	}
+	return ""
+}
+
+type Issue struct {
+	Number      int
+	Title       string
+	Body        string
+	URL         string `json:"html_url"`
+	StateReason string `json:"state_reason"`
+	PullRequest *struct {
+		URL string `json:"html_url"`
+	} `json:"pull_request"`
+}
+
+type Issues struct {
+	Common
+	Issue    Issue
+	Label    struct{ Name string }
+	Assignee struct{ Login string }
+	Changes  struct {
+		NewRepository struct {
+			FullName string `json:"full_name"`
+		} `json:"new_repository"`
+		NewIssue struct {
+			Number int
+			URL    string `json:"html_url"`
+		} `json:"new_issue"`
+	}
+}
+
+func (ev Issues) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	verb := ev.Action + "||issue"
+	var facts []event.Fact
+	view := []event.Action{{Name: p.Sprintf(viewIssue, ev.Issue.Number), URL: ev.Issue.URL}}
+	var body string
+
+	switch ev.Action {
+	case "opened":
+		body = ev.Issue.Body
+	case "closed":
+		if ev.Issue.StateReason == "not_planned" {
+			verb += "|not_planned"
+		}
+	case "reopened":
+	case "labeled":
+		facts = append(facts, event.Fact{Name: p.Sprint(issueLabel), Value: p.Sprintf("%#s", md(ev.Label.Name))})
+	case "assigned":
+		facts = append(facts, event.Fact{Name: p.Sprint(issueAssignee), Value: p.Sprintf("%#+s", md(ev.Assignee.Login))})
+	case "transferred":
+		moved := ev.Changes.NewIssue
+		facts = append(facts, event.Fact{Name: p.Sprint(issueTransferredTo), Value: p.Sprintf("%#s#%d", md(ev.Changes.NewRepository.FullName), moved.Number)})
+		view = []event.Action{{Name: p.Sprintf(viewIssue, moved.Number), URL: moved.URL}}
+	default:
+		return nil
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedIssue, "%s %m #%#d"), username, verb+"|summary", ev.Issue.Number),
+		Text:    p.Sprintf(msgUserVerbedIssue, username, verb, ev.Issue.Number, md(ev.Issue.Title)),
+		Body:    body,
+		Fact:    facts,
+		Action:  view,
+	})
+}
+
+type IssueComment struct {
+	Common
+	Issue   Issue
+	Comment struct {
+		Body string
+		URL  string `json:"html_url"`
+	}
+}
+
+func (ev IssueComment) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	verb := ev.Action + "||comment|issue"
+	if ev.Issue.PullRequest != nil {
+		verb = ev.Action + "||comment|pr"
+	}
+
+	view := []event.Action{{Name: p.Sprint(viewComment), URL: ev.Comment.URL}}
+	body := ev.Comment.Body
+	switch ev.Action {
+	case "created", "edited":
+	case "deleted":
+		view = []event.Action{{Name: p.Sprintf(viewIssue, ev.Issue.Number), URL: ev.Issue.URL}}
+		body = ""
+	default:
+		return nil
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedComment, "%s %m #%#d"), username, verb+"|summary", ev.Issue.Number),
+		Text:    p.Sprintf(msgUserVerbedIssue, username, verb, ev.Issue.Number, md(ev.Issue.Title)),
+		Body:    body,
+		Action:  view,
+	})
+}
+
+type Discussion struct {
+	Number   int
+	Title    string
+	Body     string
+	URL      string `json:"html_url"`
+	Category struct {
+		Name string
+	}
+}
+
+type DiscussionAnswer struct {
+	URL  string `json:"html_url"`
+	Body string
+	User struct {
+		Login string
+	}
+}
+
+type DiscussionEvent struct {
+	Common
+	Discussion Discussion
+	Answer     DiscussionAnswer
+	OldAnswer  DiscussionAnswer `json:"old_answer"`
+	Changes    struct {
+		Category struct {
+			From struct {
+				Name string
+			}
+		}
+	}
+}
+
+func (ev DiscussionEvent) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	disc := ev.Discussion
+	verb := ev.Action + "||discussion"
+	category := event.Fact{Name: p.Sprint(discussionCategory), Value: p.Sprintf("%#s", md(disc.Category.Name))}
+	facts := []event.Fact{category}
+	view := []event.Action{{Name: p.Sprintf(viewDisc, disc.Number), URL: disc.URL}}
+	var body string
+
+	switch ev.Action {
+	case "created":
+		body = disc.Body
+	case "answered":
+		body = ev.Answer.Body
+		facts = append(facts, event.Fact{Name: p.Sprint(discussionAnswerBy), Value: p.Sprintf("%#+s", md(ev.Answer.User.Login))})
+		view = []event.Action{{Name: p.Sprint(viewAnswer), URL: ev.Answer.URL}}
+	case "unanswered":
+		if login := ev.OldAnswer.User.Login; login != "" {
+			facts = append(facts, event.Fact{Name: p.Sprint(discussionAnswerBy), Value: p.Sprintf("%#+s", md(login))})
+		}
+	case "category_changed":
+		category.Value = p.Sprintf(msgChangedFromTo, md(ev.Changes.Category.From.Name), md(disc.Category.Name))
+		facts = []event.Fact{category}
+	case "closed", "reopened":
+	default:
+		return nil
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedDiscussion, "%s %m #%#d"), username, verb+"|summary", disc.Number),
+		Text:    p.Sprintf(msgUserVerbedDiscussion, username, verb, disc.Number, md(disc.Title)),
+		Body:    body,
+		Fact:    facts,
+		Action:  view,
+	})
+}
+
+type DiscussionComment struct {
+	Common
+	Discussion Discussion
+	Comment    struct {
+		URL  string `json:"html_url"`
+		Body string
+	}
+}
+
+func (ev DiscussionComment) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	disc := ev.Discussion
+	verb := ev.Action + "||comment|discussion"
+
+	view := []event.Action{{Name: p.Sprint(viewComment), URL: ev.Comment.URL}}
+	body := ev.Comment.Body
+	switch ev.Action {
+	case "created", "edited":
+	case "deleted":
+		view = []event.Action{{Name: p.Sprintf(viewDisc, disc.Number), URL: disc.URL}}
+		body = ""
+	default:
+		return nil
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedComment, "%s %m #%#d"), username, verb+"|summary", disc.Number),
+		Text:    p.Sprintf(msgUserVerbedDiscussion, username, verb, disc.Number, md(disc.Title)),
+		Body:    body,
+		Fact:    []event.Fact{{Name: p.Sprint(discussionCategory), Value: p.Sprintf("%#s", md(disc.Category.Name))}},
+		Action:  view,
+	})
+}
+
 type PullRequest struct {
 	Common
 	PullRequest struct {
@@ -172,42 +798,101 @@ type PullRequest struct {
 		Base struct {
 			Ref string
 		}
-		URL    string `json:"html_url"`
-		Body   string
-		Merged bool
+		URL       string `json:"html_url"`
+		Body      string
+		Merged    bool
+		Commits   int
+		AutoMerge struct {
+			MergeMethod string `json:"merge_method"`
+		} `json:"auto_merge"`
 	} `json:"pull_request"`
+	Before, After string
+	Changes       struct {
+		Title struct{ From string }
+		Base  struct {
+			Ref struct{ From string }
+		}
+	}
+	Label             struct{ Name string }
+	Assignee          struct{ Login string }
+	RequestedReviewer struct{ Login string } `json:"requested_reviewer"`
+	RequestedTeam     struct{ Name string }  `json:"requested_team"`
 }
 
 func (ev PullRequest) Event(p *message.Printer) *event.Detail {
 	head := branch(ev.PullRequest.Head.Ref)
 	base := branch(ev.PullRequest.Base.Ref)
+	username := md(ev.Sender.Login)
+	number := ev.PullRequest.Number
+	title := md(ev.PullRequest.Title)
+	verb := ev.Action + "||pr"
+	view := []event.Action{{Name: p.Sprintf(viewPR, number), URL: ev.PullRequest.URL}}
+	var text, body string
+	var facts []event.Fact
 
 	switch ev.Action {
-	case "opened", "closed":
-		username := md(ev.Sender.Login)
-		verb := ev.Action + "||pr"
+	case "opened", "closed", "reopened":
 		if ev.PullRequest.Draft {
 			verb += "|draft"
 		}
 		if ev.PullRequest.Merged {
 			verb += "|merged"
 		}
-		var title string
 		if head != "" && base != "" {
-			title = p.Sprintf(msgUserVerbedPRBranch, username, verb, ev.PullRequest.Number, md(head), md(base))
-		} else {
-			title = p.Sprintf(msgUserVerbedPRTitle, username, verb, ev.PullRequest.Number, md(ev.PullRequest.Title))
+			text = p.Sprintf(msgUserVerbedPRBranch, username, verb, number, md(head), md(base))
 		}
-		summaryVerb := verb + "|summary"
-		return fillEvent(p, ev.Common, event.Detail{
-			Summary: p.Sprintf(message.Key(msgVerbedPR, "%s %m #%#d"), username, summaryVerb, ev.PullRequest.Number),
-			Text:    title,
-			Action:  []event.Action{{Name: p.Sprintf(viewPR, ev.PullRequest.Number), URL: ev.PullRequest.URL}},
-			Body:    ev.PullRequest.Body,
-		})
-	case "reviewed":
+		if ev.Action != "reopened" {
+			body = ev.PullRequest.Body
+		}
+	case "ready_for_review":
+		text = p.Sprintf(msgUserReadiedPR, username, number, title)
+	case "converted_to_draft":
+		text = p.Sprintf(msgUserDraftedPR, username, number, title)
+	case "synchronize":
+		text = p.Sprintf(message.Key(msgUserSyncedPR, "%#+s updated #%#d: %#+s (%d commits in total)"), username, number, title, ev.PullRequest.Commits)
+		if ev.Before != "" && ev.After != "" {
+			view = append(view, event.Action{Name: p.Sprint(viewChanges), URL: fmt.Sprintf("%s/compare/%s...%s", ev.Repository.URL, ev.Before, ev.After)})
+		}
+	case "edited":
+		// only retitling and retargeting are worth a message
+		if from := ev.Changes.Title.From; from != "" {
+			facts = append(facts, event.Fact{Name: p.Sprint(prPreviousTitle), Value: p.Sprintf("%#s", md(from))})
+		}
+		if from := ev.Changes.Base.Ref.From; from != "" {
+			facts = append(facts, event.Fact{Name: p.Sprint(prBase), Value: p.Sprintf(msgChangedFromTo, md(branch(from)), md(base))})
+		}
+		if len(facts) == 0 {
+			return nil
+		}
+	case "labeled", "unlabeled":
+		facts = append(facts, event.Fact{Name: p.Sprint(issueLabel), Value: p.Sprintf("%#s", md(ev.Label.Name))})
+	case "assigned":
+		facts = append(facts, event.Fact{Name: p.Sprint(issueAssignee), Value: p.Sprintf("%#+s", md(ev.Assignee.Login))})
+	case "review_requested":
+		reviewer := ev.RequestedReviewer.Login
+		if reviewer == "" {
+			reviewer = ev.RequestedTeam.Name
+		}
+		text = p.Sprintf(msgUserRequestedReview, username, md(reviewer), number, title)
+	case "auto_merge_enabled":
+		if method := ev.PullRequest.AutoMerge.MergeMethod; method != "" {
+			facts = append(facts, event.Fact{Name: p.Sprint(prMergeMethod), Value: p.Sprintf("%#s", md(method))})
+		}
+	case "auto_merge_disabled":
+	default:
+		return nil
 	}
-	return nil
+
+	if text == "" {
+		text = p.Sprintf(msgUserVerbedPRTitle, username, verb, number, title)
+	}
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedPR, "%s %m #%#d"), username, verb+"|summary", number),
+		Text:    text,
+		Action:  view,
+		Body:    body,
+		Fact:    facts,
+	})
 }
 
 type PullRequestReview struct {
@@ -256,10 +941,176 @@ func (ev *PullRequestReview) Event(p *message.Printer) *event.Detail {
 	return nil
 }
 
-type PullRequestReviewComment struct{}
+type PullRequestReviewComment struct {
+	Common
+	PullRequest struct {
+		Number int
+		Title  string
+		URL    string `json:"html_url"`
+	} `json:"pull_request"`
+	Comment struct {
+		Path              string
+		DiffHunk          string `json:"diff_hunk"`
+		Line              int
+		StartLine         int `json:"start_line"`
+		OriginalLine      int `json:"original_line"`
+		OriginalStartLine int `json:"original_start_line"`
+		InReplyTo         int `json:"in_reply_to_id"`
+		Body              string
+		URL               string `json:"html_url"`
+	}
+}
 
 func (ev PullRequestReviewComment) Event(p *message.Printer) *event.Detail {
-	return nil
+	username := md(ev.Sender.Login)
+	verb := ev.Action + "||review_comment"
+	if ev.Comment.InReplyTo != 0 {
+		verb += "|reply"
+	}
+
+	view := []event.Action{{Name: p.Sprint(viewComment), URL: ev.Comment.URL}}
+	body := ev.Comment.Body
+	switch ev.Action {
+	case "created", "edited":
+		if hunk := diffHunk(ev.Comment.DiffHunk, 4); hunk != "" {
+			body = "```diff\n" + hunk + "\n```\n\n" + body
+		}
+	case "deleted":
+		view = []event.Action{{Name: p.Sprintf(viewPR, ev.PullRequest.Number), URL: ev.PullRequest.URL}}
+		body = ""
+	default:
+		return nil
+	}
+
+	// line and start_line go null when the comment is outdated
+	line, start := ev.Comment.Line, ev.Comment.StartLine
+	if line == 0 {
+		line, start = ev.Comment.OriginalLine, ev.Comment.OriginalStartLine
+	}
+	location := ev.Comment.Path
+	switch {
+	case start != 0 && start != line:
+		location = fmt.Sprintf("%s:%d-%d", location, start, line)
+	case line != 0:
+		location = fmt.Sprintf("%s:%d", location, line)
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedReviewComment, "%s %m #%#d"), username, verb+"|summary", ev.PullRequest.Number),
+		Text:    p.Sprintf(msgUserVerbedPRTitle, username, verb, ev.PullRequest.Number, md(ev.PullRequest.Title)),
+		Body:    body,
+		Fact:    []event.Fact{{Name: p.Sprint(reviewCommentFile), Value: p.Sprintf("%#s", md(location))}},
+		Action:  view,
+	})
+}
+
+// diffHunk trims a review comment's diff_hunk to its header and the last n
+// lines, which end at the commented line, much like GitHub displays it.
+func diffHunk(hunk string, n int) string {
+	lines := strings.Split(strings.TrimRight(hunk, "\n"), "\n")
+	if len(lines) <= n+1 {
+		return strings.Join(lines, "\n")
+	}
+	return lines[0] + "\n" + strings.Join(lines[len(lines)-n:], "\n")
+}
+
+type MergeGroup struct {
+	Common
+	Reason     string
+	MergeGroup struct {
+		HeadSHA    string `json:"head_sha"`
+		HeadRef    string `json:"head_ref"`
+		BaseRef    string `json:"base_ref"`
+		HeadCommit struct {
+			Message string
+		} `json:"head_commit"`
+	} `json:"merge_group"`
+}
+
+func (ev MergeGroup) Event(p *message.Printer) *event.Detail {
+	group := ev.MergeGroup
+	base := md(branch(group.BaseRef))
+	verb := ev.Action + "||merge_group"
+	color := themePending
+	switch ev.Action {
+	case "checks_requested":
+	case "destroyed":
+		switch ev.Reason {
+		case "merged":
+			color = themeSuccess
+		case "invalidated", "dequeued":
+			color = themeInactive
+		default:
+			return nil
+		}
+		verb += "|" + ev.Reason
+	default:
+		return nil
+	}
+
+	pulls := mergeGroupPulls(group.HeadRef, group.HeadCommit.Message)
+	numbers := make([]string, len(pulls))
+	links := make([]string, len(pulls))
+	for i, n := range pulls {
+		numbers[i] = p.Sprintf("#%#d", n)
+		links[i] = p.Sprintf("[#%#d](%s/pull/%d)", n, ev.Repository.URL, n)
+	}
+
+	subject := strings.Join(numbers, ", ")
+	if subject == "" && group.HeadSHA != "" {
+		subject = shortSHA(group.HeadSHA)
+	} else if subject == "" {
+		subject = p.Sprint(mergeGroupUnnamed)
+	}
+
+	var facts []event.Fact
+	if len(links) > 0 {
+		facts = append(facts, event.Fact{Name: p.Sprint(workflowPullRequests), Value: strings.Join(links, ", ")})
+	}
+	facts = append(facts, event.Fact{Name: p.Sprint(prBase), Value: p.Sprintf("%#s", base)})
+	var view []event.Action
+	if sha := group.HeadSHA; sha != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(mergeGroupHead), Value: fmt.Sprintf("[%s](%s/commit/%s)", shortSHA(sha), ev.Repository.URL, sha)})
+		view = []event.Action{{Name: p.Sprint(viewChecks), URL: ev.Repository.URL + "/commit/" + sha + "/checks"}}
+	}
+	if ev.Reason != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(mergeGroupReason), Value: p.Sprintf("%#s", md(ev.Reason))})
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		ThemeColor: color,
+		Summary:    p.Sprintf(verb+"|summary", subject, base),
+		Text:       p.Sprintf(verb, subject, base),
+		Fact:       facts,
+		Action:     view,
+	})
+}
+
+var (
+	queueRefPR    = regexp.MustCompile(`/pr-(\d+)-[0-9a-f]+$`)
+	queueCommitPR = regexp.MustCompile(`(?:\(#|Merge pull request #)(\d+)`)
+)
+
+// mergeGroupPulls finds the pull requests in a merge group. The payload
+// doesn't list them, but the queue's branch names the last one, and the head
+// commit's message names those merged or squashed into it.
+func mergeGroupPulls(headRef, commitMessage string) []int {
+	var pulls []int
+	seen := map[int]bool{}
+	add := func(match []string) {
+		if n, err := strconv.Atoi(match[1]); err == nil && !seen[n] {
+			seen[n] = true
+			pulls = append(pulls, n)
+		}
+	}
+	for _, match := range queueCommitPR.FindAllStringSubmatch(commitMessage, -1) {
+		add(match)
+	}
+	if match := queueRefPR.FindStringSubmatch(headRef); match != nil {
+		add(match)
+	}
+	sort.Ints(pulls)
+	return pulls
 }
 
 type Push struct {
@@ -323,11 +1174,366 @@ func (ev Push) Event(p *message.Printer) *event.Detail {
 	return fillEvent(p, ev.Common, event.Detail{Summary: summary, Text: text, Fact: commits, Action: view})
 }
 
+type Release struct {
+	Common
+	Release struct {
+		TagName    string `json:"tag_name"`
+		Name       string
+		Body       string
+		URL        string `json:"html_url"`
+		Draft      bool
+		Prerelease bool
+		Author     struct {
+			Login string
+		}
+		Assets []struct {
+			Name string
+			URL  string `json:"browser_download_url"`
+		}
+	}
+}
+
+func (ev Release) Event(p *message.Printer) *event.Detail {
+	if ev.Release.Draft {
+		return nil // drafts aren't visible until published
+	}
+
+	switch ev.Action {
+	case "published":
+		// GitHub also sends prereleased for a published prerelease
+		if ev.Release.Prerelease {
+			return nil
+		}
+	case "prereleased", "edited", "deleted":
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	tagName := md(ev.Release.TagName)
+	verb := ev.Action + "||release"
+	var text string
+	if name := ev.Release.Name; name != "" && name != ev.Release.TagName {
+		text = p.Sprintf(msgUserVerbedReleaseName, username, verb, tagName, md(name))
+	} else {
+		text = p.Sprintf(msgUserVerbedRelease, username, verb, tagName)
+	}
+
+	var facts []event.Fact
+	if author := ev.Release.Author.Login; author != "" && author != ev.Sender.Login {
+		facts = append(facts, event.Fact{Name: p.Sprint(releaseAuthor), Value: p.Sprintf("%#+s", md(author))})
+	}
+
+	detail := event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedRelease, "%s %m %s"), username, verb+"|summary", tagName),
+		Text:    text,
+		Fact:    facts,
+	}
+	if ev.Action != "deleted" {
+		detail.Body = ev.Release.Body
+		detail.Action = append(detail.Action, event.Action{Name: p.Sprint(viewRelease), URL: ev.Release.URL})
+		for _, asset := range ev.Release.Assets {
+			detail.Action = append(detail.Action, event.Action{Name: asset.Name, URL: asset.URL})
+		}
+	}
+	return fillEvent(p, ev.Common, detail)
+}
+
+type Status struct {
+	Common
+	SHA         string
+	State       string
+	Context     string
+	Description string
+	TargetURL   string `json:"target_url"`
+	AvatarURL   string `json:"avatar_url"`
+	Branches    []struct {
+		Name string
+	}
+	combined *struct {
+		State    string
+		Statuses []struct {
+			Context   string
+			State     string
+			TargetURL string `json:"target_url"`
+		}
+	}
+}
+
+func (ev *Status) expand(ctx context.Context, api *client) error {
+	path := fmt.Sprintf("/repos/%s/commits/%s/status", ev.Repository.FullName, ev.SHA)
+	combined := ev.combined
+	if err := api.get(ctx, path, &combined); err != nil {
+		return err
+	}
+	ev.combined = combined
+	return nil
+}
+
+func (ev *Status) Event(p *message.Printer) *event.Detail {
+	if ev.State == "pending" {
+		return nil
+	}
+	var headBranch string
+	if len(ev.Branches) > 0 {
+		headBranch = ev.Branches[0].Name
+	}
+
+	if ev.combined == nil {
+		detail := checksEvent(p, ev.Common, ev.Context, ev.AvatarURL, statusConclusion(ev.State), headBranch, ev.SHA, nil)
+		if ev.State != "success" {
+			detail.Body = ev.Description
+			if ev.TargetURL != "" {
+				detail.Action = []event.Action{{Name: p.Sprint(viewDetails), URL: ev.TargetURL}}
+			}
+		}
+		return detail
+	}
+
+	// Each context sends its own event; only the one that finishes last reports.
+	if ev.combined.State == "pending" {
+		return nil
+	}
+	var checks []checkResult
+	for _, status := range ev.combined.Statuses {
+		if status.State == "pending" {
+			return nil
+		}
+		checks = append(checks, checkResult{status.Context, statusConclusion(status.State), status.TargetURL})
+	}
+	return checksEvent(p, ev.Common, p.Sprint(statusChecks), ev.AvatarURL, statusConclusion(ev.combined.State), headBranch, ev.SHA, checks)
+}
+
+// statusConclusion maps a commit status state onto the job statuses.
+func statusConclusion(state string) string {
+	switch state {
+	case "success":
+		return "success"
+	default: // failure, error
+		return "failure"
+	}
+}
+
+type WorkflowRun struct {
+	Common
+	WorkflowRun struct {
+		Name            string
+		URL             string `json:"html_url"`
+		HeadBranch      string `json:"head_branch"`
+		HeadSHA         string `json:"head_sha"`
+		RunAttempt      int    `json:"run_attempt"`
+		Conclusion      string
+		RunStartedAt    time.Time `json:"run_started_at"`
+		UpdatedAt       time.Time `json:"updated_at"`
+		TriggeringActor struct {
+			Login string
+		} `json:"triggering_actor"`
+		PullRequests []struct {
+			Number int
+		} `json:"pull_requests"`
+	} `json:"workflow_run"`
+}
+
+func (ev WorkflowRun) Event(p *message.Printer) *event.Detail {
+	if ev.Action != "completed" {
+		return nil
+	}
+	run := ev.WorkflowRun
+
+	facts := workflowFacts(p, run.UpdatedAt.Sub(run.RunStartedAt), run.RunAttempt, run.TriggeringActor.Login)
+	if len(run.PullRequests) > 0 {
+		var links []string
+		for _, pr := range run.PullRequests {
+			links = append(links, fmt.Sprintf("[#%d](%s/pull/%d)", pr.Number, ev.Repository.URL, pr.Number))
+		}
+		facts = append(facts, event.Fact{Name: p.Sprint(workflowPullRequests), Value: strings.Join(links, ", ")})
+	}
+
+	return workflowEvent(p, ev.Common, run.Name, run.Conclusion, run.HeadBranch, run.HeadSHA, run.URL, facts)
+}
+
+type WorkflowJob struct {
+	Common
+	WorkflowJob struct {
+		Name         string
+		WorkflowName string `json:"workflow_name"`
+		URL          string `json:"html_url"`
+		HeadBranch   string `json:"head_branch"`
+		HeadSHA      string `json:"head_sha"`
+		RunAttempt   int    `json:"run_attempt"`
+		Conclusion   string
+		StartedAt    time.Time `json:"started_at"`
+		CompletedAt  time.Time `json:"completed_at"`
+	} `json:"workflow_job"`
+}
+
+func (ev WorkflowJob) Event(p *message.Printer) *event.Detail {
+	if ev.Action != "completed" {
+		return nil
+	}
+	job := ev.WorkflowJob
+
+	name := job.Name
+	if job.WorkflowName != "" {
+		name = job.WorkflowName + " / " + name
+	}
+	facts := workflowFacts(p, job.CompletedAt.Sub(job.StartedAt), job.RunAttempt, ev.Sender.Login)
+	return workflowEvent(p, ev.Common, name, job.Conclusion, job.HeadBranch, job.HeadSHA, job.URL, facts)
+}
+
+// workflowEvent reports a completed workflow run or job the same way
+// JobStatus reports a job-status input.
+func workflowEvent(p *message.Printer, c Common, name, conclusion, headBranch, headSHA, url string, facts []event.Fact) *event.Detail {
+	jobName := md(name)
+	status := jobConclusion(conclusion)
+	jobStatus := status + "||job"
+	symbol := status + "||job|sym"
+
+	refName := md(headBranch)
+	refOrSha := refName
+	commitLinkMarkdown := ""
+	if len(headSHA) >= 9 {
+		commitLinkMarkdown = fmt.Sprintf(`[%s](%s/commit/%s)`, headSHA[:9], c.Repository.URL, headSHA)
+		if refName == "" {
+			refOrSha = md(headSHA[:9])
+		}
+	}
+
+	return fillEvent(p, c, event.Detail{
+		Username: string(jobName),
+		Status:   status,
+		Summary:  p.Sprintf(message.Key(msgWorkflowStatusSummary, "%s %m for %s"), jobName, jobStatus, refOrSha),
+		Text:     p.Sprintf(message.Key(msgWorkflowStatus, "%m %#s %m for %#+s"), symbol, jobName, jobStatus, refOrSha),
+		Body:     p.Sprintf(message.Key(msgWorkflowDetail, "%m Workflow %#+s %m for %+s commit %s"), symbol, jobName, jobStatus, refName, commitLinkMarkdown),
+		Fact:     facts,
+		Action:   []event.Action{{Name: p.Sprint(viewRun), URL: url}},
+	})
+}
+
+func workflowFacts(p *message.Printer, duration time.Duration, attempt int, actor string) []event.Fact {
+	var facts []event.Fact
+	if duration > 0 {
+		facts = append(facts, event.Fact{Name: p.Sprint(workflowDuration), Value: duration.Round(time.Second).String()})
+	}
+	if attempt > 1 {
+		facts = append(facts, event.Fact{Name: p.Sprint(workflowAttempt), Value: p.Sprint(attempt)})
+	}
+	if actor != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(workflowTriggeredBy), Value: p.Sprintf("%#+s", md(actor))})
+	}
+	return facts
+}
+
+// jobConclusion maps a check conclusion onto the job statuses we have words for.
+func jobConclusion(conclusion string) string {
+	switch conclusion {
+	case "success", "failure", "cancelled", "skipped", "timed_out":
+		return conclusion
+	case "startup_failure":
+		return "failure"
+	default: // neutral, stale, action_required
+		return "skipped"
+	}
+}
+
+// Trigger reports a workflow started by hand, on a schedule, or through the
+// API, rather than by activity in the repository.
+type Trigger struct {
+	Common
+	Inputs        map[string]json.RawMessage
+	ClientPayload map[string]json.RawMessage `json:"client_payload"`
+	Schedule      string
+	Branch        string
+
+	kind     string
+	workflow string
+	runURL   string
+}
+
+const triggersInput = "triggers"
+
+func isTrigger(event string) bool {
+	switch event {
+	case "workflow_dispatch", "schedule", "repository_dispatch":
+		return true
+	}
+	return false
+}
+
+func (ev Trigger) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	workflow := md(ev.workflow)
+	ref := md(branch(ev.Ref))
+	if ref == "" {
+		ref = md(ev.Branch)
+	}
+	if ref == "" {
+		ref = md(ev.Repository.DefaultBranch)
+	}
+
+	var view []event.Action
+	if ev.runURL != "" {
+		view = []event.Action{{Name: p.Sprint(viewRun), URL: ev.runURL}}
+	}
+
+	detail := event.Detail{Action: view}
+	switch ev.kind {
+	case "workflow_dispatch":
+		detail.Summary = p.Sprintf(message.Key(msgStartedSummary, "%s started %s"), username, workflow)
+		detail.Text = p.Sprintf(msgUserStartedWorkflow, username, workflow, ref)
+		detail.Fact = triggerFacts(p, ev.Inputs)
+	case "schedule":
+		detail.Username = string(workflow)
+		detail.Summary = p.Sprintf(message.Key(msgScheduledSummary, "%s started on schedule"), workflow)
+		detail.Text = p.Sprintf(msgScheduledWorkflow, workflow, ref)
+		if ev.Schedule != "" {
+			detail.Fact = []event.Fact{{Name: p.Sprint(triggerCron), Value: p.Sprintf("%#s", md(ev.Schedule))}}
+		}
+	case "repository_dispatch":
+		detail.Summary = p.Sprintf(message.Key(msgDispatchedSummary, "%s dispatched %s"), username, md(ev.Action))
+		detail.Text = p.Sprintf(msgUserDispatched, username, md(ev.Action), workflow, ref)
+		detail.Fact = triggerFacts(p, ev.ClientPayload)
+	default:
+		return nil
+	}
+	return fillEvent(p, ev.Common, detail)
+}
+
+// triggerFacts lists workflow inputs or a dispatch's client payload, with
+// strings shown bare and anything else as compact JSON.
+func triggerFacts(p *message.Printer, values map[string]json.RawMessage) []event.Fact {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var facts []event.Fact
+	for _, name := range names {
+		var value string
+		if err := json.Unmarshal(values[name], &value); err != nil {
+			compact := bytes.NewBuffer(nil)
+			if err := json.Compact(compact, values[name]); err != nil {
+				continue
+			}
+			value = compact.String()
+		}
+		facts = append(facts, event.Fact{Name: p.Sprintf("%#s", md(name)), Value: p.Sprintf("%#s", md(value))})
+	}
+	return facts
+}
+
 type JobStatus struct {
 	Common
+	Branch     string
+	MergeGroup struct {
+		HeadSHA string `json:"head_sha"`
+		BaseRef string `json:"base_ref"`
+	} `json:"merge_group"`
 	JobName   string
 	JobStatus string
 	JobURL    string
+	Trigger   string
 }
 
 func (ev JobStatus) Event(p *message.Printer) *event.Detail {
@@ -336,21 +1542,56 @@ func (ev JobStatus) Event(p *message.Printer) *event.Detail {
 	symbol := ev.JobStatus + "||job|sym"
 
 	refName := md(strings.TrimPrefix(strings.TrimPrefix(ev.Ref, "refs/tags/"), "refs/heads/"))
+	if refName == "" {
+		refName = md(ev.Branch)
+	}
+	if refName == "" {
+		refName = md(branch(ev.MergeGroup.BaseRef))
+	}
+	if refName == "" && ev.HeadCommit.ID == "" {
+		refName = md(ev.Repository.DefaultBranch)
+	}
 	commitLinkMarkdown := ""
 	if ev.HeadCommit.ID != "" && ev.HeadCommit.URL != "" {
-		commitLinkMarkdown = fmt.Sprintf(`[%s](%s)`, ev.HeadCommit.ID[:9], ev.HeadCommit.URL)
+		commitLinkMarkdown = fmt.Sprintf(`[%s](%s)`, shortSHA(ev.HeadCommit.ID), ev.HeadCommit.URL)
+	} else if sha := ev.MergeGroup.HeadSHA; sha != "" {
+		commitLinkMarkdown = fmt.Sprintf(`[%s](%s/commit/%s)`, shortSHA(sha), ev.Repository.URL, sha)
 	}
 
 	refOrSha := refName
 	if refName == "" {
-		refOrSha = md(ev.HeadCommit.ID[:9])
+		refOrSha = md(shortSHA(ev.HeadCommit.ID))
+	}
+
+	text := p.Sprintf(message.Key(msgWorkflowStatus, "%m %#s %m for %#+s"), symbol, jobName, jobStatus, refOrSha)
+	if isTrigger(ev.Trigger) || ev.Trigger == "merge_group" {
+		text = p.Sprintf(message.Key(msgWorkflowStatusTrigger, "%m %#s %m for %#+s (%m)"), symbol, jobName, jobStatus, refOrSha, ev.Trigger+"||trigger")
+	}
+
+	body := p.Sprintf(message.Key(msgWorkflowDetail, "%m Workflow %#+s %m for %+s commit %s"), symbol, jobName, jobStatus, refName, commitLinkMarkdown)
+	if commitLinkMarkdown == "" {
+		body = p.Sprintf(message.Key(msgWorkflowDetailRef, "%m Workflow %#+s %m for %+s"), symbol, jobName, jobStatus, refName)
+	}
+
+	// Pushes, dispatches and scheduled runs build a branch itself; others,
+	// like pull requests and queued merges, only build on one.
+	var onBranch string
+	switch {
+	case strings.HasPrefix(ev.Ref, "refs/heads/"):
+		onBranch = branch(ev.Ref)
+	case ev.Ref == "" && (ev.Trigger == "schedule" || ev.Trigger == "repository_dispatch"):
+		onBranch = ev.Repository.DefaultBranch
 	}
 
 	return fillEvent(p, ev.Common, event.Detail{
-		Username: string(jobName),
-		Summary:  p.Sprintf(message.Key(msgWorkflowStatusSummary, "%s %m for %s"), jobName, jobStatus, refOrSha),
-		Text:     p.Sprintf(message.Key(msgWorkflowStatus, "%m %#s %m for %#+s"), symbol, jobName, jobStatus, refOrSha),
-		Body:     p.Sprintf(message.Key(msgWorkflowDetail, "%m Workflow %#+s %m for %+s commit %s"), symbol, jobName, jobStatus, refName, commitLinkMarkdown),
+		Username:      string(jobName),
+		Status:        ev.JobStatus,
+		Workflow:      ev.JobName,
+		Branch:        onBranch,
+		DefaultBranch: ev.Repository.DefaultBranch,
+		Summary:       p.Sprintf(message.Key(msgWorkflowStatusSummary, "%s %m for %s"), jobName, jobStatus, refOrSha),
+		Text:          text,
+		Body:          body,
 	})
 }
 
@@ -381,6 +1622,14 @@ func (s md) Format(f fmt.State, c rune) {
 	}
 }
 
+// shortSHA abbreviates a commit ID the way GitHub shows it.
+func shortSHA(sha string) string {
+	if len(sha) > 9 {
+		return sha[:9]
+	}
+	return sha
+}
+
 func branch(ref string) string {
 	return strings.TrimPrefix(ref, "refs/heads/")
 }
@@ -418,6 +1667,7 @@ func Report(ctx context.Context, p *message.Printer, workflow, status, runID str
 	job.JobName = workflow
 	job.JobStatus = status
 	job.JobURL = job.Repository.URL + "/actions/runs/" + runID
+	job.Trigger = os.Getenv("GITHUB_EVENT_NAME")
 
 	return sum.Event(p), err
 }
@@ -440,34 +1690,20 @@ func ParseFile(ctx context.Context, p *message.Printer, event string, payloadPat
 	if err != nil {
 		return nil, err
 	}
+	expand(ctx, e)
+	if t, ok := e.(*Trigger); ok {
+		t.workflow = os.Getenv("GITHUB_WORKFLOW")
+		if runID := os.Getenv("GITHUB_RUN_ID"); runID != "" {
+			t.runURL = t.Repository.URL + "/actions/runs/" + runID
+		}
+	}
 	return e.Event(p), nil
 }
 
 func parse(ctx context.Context, event string, payload io.Reader) (eventer, error) {
 	json := json.NewDecoder(payload)
 
-	var sum eventer
-
-	switch event {
-	case "check_run":
-		sum = &CheckRun{}
-	case "create":
-		sum = &Create{}
-	case "delete":
-		sum = &Delete{}
-	case "pull_request":
-		sum = &PullRequest{}
-	case "pull_request_review":
-		sum = &PullRequestReview{}
-	case "pull_request_review_comment":
-		sum = &PullRequestReviewComment{}
-	case "push":
-		sum = &Push{}
-	case "_job_status":
-		sum = &JobStatus{}
-	default:
-		return nil, fmt.Errorf("unsupported event: %v", event)
-	}
+	sum := newEventer(event)
 	if err := json.Decode(sum); err != nil {
 		return nil, fmt.Errorf("decoding webhook: %w", err)
 	}
@@ -10,9 +10,26 @@ const (
 	viewPush     = "View Push"
 	viewPR       = "View #%#d"
 	viewReview   = "View Review"
+	viewRelease  = "View Release"
+	viewIssue    = "View #%#d"
+	viewComment  = "View Comment"
+	viewChanges  = "View Changes"
+	viewRun      = "View Run"
+	viewChecks   = "View Checks"
+	viewDetails  = "View Details"
 	viewOnGithub = "View on GitHub"
+	viewEnv      = "Open Environment"
+	viewLogs     = "View Logs"
+	viewAlert    = "View Alert"
+	viewAnswer   = "View Answer"
+	viewDisc     = "View #%#d"
 	themeColor   = "#6e5494"
 
+	themeSuccess  = "#2cbe4e"
+	themeFailure  = "#cb2431"
+	themePending  = "#dbab09"
+	themeInactive = "#6a737d"
+
 	msgRepeatCommitMessageLink = "%#s [\U0001f50D](%s)"
 	msgNewCommitMessageLink    = "%#+s [\U0001f50D](%s)"
 	msgCompareBaseToBranch     = "Compare %s...%s"
@@ -22,18 +39,55 @@ const (
 	msgUserVerbedPRBranch      = "%#+s %m #%#d: %#+s into %#+s"
 	msgUserVerbedPRTitle       = "%#+s %m #%#d: %#+s"
 	msgUserReviewState         = "%#s %m [#%#d: %#s](%s)"
+	msgUserRequestedReview     = "%#+s requested review from %#+s on #%#d: %#+s"
+	msgUserReadiedPR           = "%#+s marked #%#d ready for review: %#+s"
+	msgUserDraftedPR           = "%#+s converted #%#d to a draft: %#+s"
+	msgUserSyncedPR            = "synced pr"
+	msgChangedFromTo           = "%#s → %#+s"
+	msgCheckResult             = "%m %m"
+	msgUserCreatedDeployment   = "%#+s started deploying %#+s to %#+s"
+	msgDeploymentStatus        = "%m Deployment of %#+s to %#+s %m"
+	msgAlertText               = "%m #%d %m: %#+s"
+	msgUserVerbedRepo          = "%#+s %m %#+s"
+	msgUserVerbedMember        = "%#+s %m %#+s"
+	msgUserVerbedProtection    = "%#+s %m for %#+s"
+	msgUserForked              = "%#+s forked %#+s to %#+s"
+	msgUserStartedWorkflow     = "%#+s started %#+s on %#+s"
+	msgScheduledWorkflow       = "Scheduled run of %#+s started on %#+s"
+	msgUserDispatched          = "%#+s dispatched %#+s, starting %#+s on %#+s"
 	msgUserEditedReview        = "%#+s edited a review on **#%#d**"
 	msgUserDismissedReview     = "%#+s dismissed a review on **#%#d**"
 	msgUserSubmittedReview     = "%#+s submitted a review on **#%#d**"
 	msgUserCommentedOn         = "%#+s commented on **#%#d**"
+	msgUserVerbedIssue         = "%#+s %m #%#d: %#+s"
+	msgUserVerbedDiscussion    = "%#+s %m #%#d: %#+s"
+	msgUserVerbedRelease       = "%#+s %m %#+s"
+	msgUserVerbedReleaseName   = "%#+s %m %#+s: %#+s"
 	msgVerbedPR                = "verbed pr"
 	msgReviewedPR              = "reviewed pr"
 	msgCommentedPR             = "commented pr"
 	msgEditedReview            = "edited review"
 	msgDismissedReview         = "dismissed review"
+	msgVerbedRelease           = "verbed release"
+	msgVerbedIssue             = "verbed issue"
+	msgVerbedDiscussion        = "verbed discussion"
+	msgVerbedComment           = "verbed comment"
+	msgVerbedReviewComment     = "verbed review comment"
+	msgDeployingSummary        = "created||deployment|summary"
+	msgDeploymentStatusSummary = "status||deployment|summary"
+	msgAlertSummary            = "summary||alert"
+	msgVerbedRepoSummary       = "verbed||repository|summary"
+	msgVerbedMemberSummary     = "verbed||member|summary"
+	msgVerbedProtectionSummary = "verbed||branch_protection|summary"
+	msgForkedSummary           = "forked||summary"
 	msgWorkflowStatusSummary   = "status||job|summary"
 	msgWorkflowStatus          = "status||job"
+	msgWorkflowStatusTrigger   = "status||job|trigger"
+	msgStartedSummary          = "started||trigger|summary"
+	msgScheduledSummary        = "scheduled||trigger|summary"
+	msgDispatchedSummary       = "dispatched||trigger|summary"
 	msgWorkflowDetail          = "detail||job"
+	msgWorkflowDetailRef       = "detail||job|ref"
 
 	prChangesRequested    = "changes_requested||review"
 	prEditedReview        = "edited||review"
@@ -41,16 +95,116 @@ const (
 	prCommented           = "commented||review"
 	prOpened              = "opened||pr"
 	prOpenedDraft         = "opened||pr|draft"
-	prClosed              = "closed||pr|"
+	prClosed              = "closed||pr"
+	prClosedDraft         = "closed||pr|draft"
 	prClosedMerged        = "closed||pr|merged"
+	prReopened            = "reopened||pr"
+	prReopenedDraft       = "reopened||pr|draft"
+	prEdited              = "edited||pr"
+	prLabeled             = "labeled||pr"
+	prUnlabeled           = "unlabeled||pr"
+	prAssigned            = "assigned||pr"
+	prAutoMergeEnabled    = "auto_merge_enabled||pr"
+	prAutoMergeDisabled   = "auto_merge_disabled||pr"
 	prOpenedSummary       = "opened||pr|summary"
 	prOpenedDraftSummary  = "opened||pr|draft|summary"
 	prClosedSummary       = "closed||pr|summary"
+	prClosedDraftSummary  = "closed||pr|draft|summary"
 	prClosedMergedSummary = "closed||pr|merged|summary"
+	prReopenedSummary     = "reopened||pr|summary"
+	prReopenedDraftSum    = "reopened||pr|draft|summary"
+	prReadySummary        = "ready_for_review||pr|summary"
+	prDraftedSummary      = "converted_to_draft||pr|summary"
+	prSyncedSummary       = "synchronize||pr|summary"
+	prEditedSummary       = "edited||pr|summary"
+	prLabeledSummary      = "labeled||pr|summary"
+	prUnlabeledSummary    = "unlabeled||pr|summary"
+	prAssignedSummary     = "assigned||pr|summary"
+	prReviewReqSummary    = "review_requested||pr|summary"
+	prAutoMergeOnSummary  = "auto_merge_enabled||pr|summary"
+	prAutoMergeOffSummary = "auto_merge_disabled||pr|summary"
+	prPreviousTitle       = "Previous title"
+	prBase                = "Base"
+	prMergeMethod         = "Merge method"
 	createTag             = "tag||create"
 	deleteTag             = "tag||delete"
 	deleteBranch          = "branch||delete"
 
+	issueOpened                = "opened||issue"
+	issueClosed                = "closed||issue"
+	issueClosedNotPlanned      = "closed||issue|not_planned"
+	issueReopened              = "reopened||issue"
+	issueLabeled               = "labeled||issue"
+	issueAssigned              = "assigned||issue"
+	issueTransferred           = "transferred||issue"
+	issueOpenedSummary         = "opened||issue|summary"
+	issueClosedSummary         = "closed||issue|summary"
+	issueClosedNotPlannedSum   = "closed||issue|not_planned|summary"
+	issueReopenedSummary       = "reopened||issue|summary"
+	issueLabeledSummary        = "labeled||issue|summary"
+	issueAssignedSummary       = "assigned||issue|summary"
+	issueTransferredSummary    = "transferred||issue|summary"
+	issueLabel                 = "Label"
+	issueAssignee              = "Assignee"
+	issueTransferredTo         = "Transferred to"
+	commentCreatedIssue        = "created||comment|issue"
+	commentEditedIssue         = "edited||comment|issue"
+	commentDeletedIssue        = "deleted||comment|issue"
+	commentCreatedPR           = "created||comment|pr"
+	commentEditedPR            = "edited||comment|pr"
+	commentDeletedPR           = "deleted||comment|pr"
+	commentCreatedIssueSummary = "created||comment|issue|summary"
+	commentEditedIssueSummary  = "edited||comment|issue|summary"
+	commentDeletedIssueSummary = "deleted||comment|issue|summary"
+	commentCreatedDisc         = "created||comment|discussion"
+	commentEditedDisc          = "edited||comment|discussion"
+	commentDeletedDisc         = "deleted||comment|discussion"
+	commentCreatedPRSummary    = "created||comment|pr|summary"
+	commentEditedPRSummary     = "edited||comment|pr|summary"
+	commentDeletedPRSummary    = "deleted||comment|pr|summary"
+	commentCreatedDiscSummary  = "created||comment|discussion|summary"
+	commentEditedDiscSummary   = "edited||comment|discussion|summary"
+	commentDeletedDiscSummary  = "deleted||comment|discussion|summary"
+
+	discussionCreated           = "created||discussion"
+	discussionAnswered          = "answered||discussion"
+	discussionUnanswered        = "unanswered||discussion"
+	discussionCategoryChanged   = "category_changed||discussion"
+	discussionClosed            = "closed||discussion"
+	discussionReopened          = "reopened||discussion"
+	discussionCreatedSummary    = "created||discussion|summary"
+	discussionAnsweredSummary   = "answered||discussion|summary"
+	discussionUnansweredSummary = "unanswered||discussion|summary"
+	discussionCategorySummary   = "category_changed||discussion|summary"
+	discussionClosedSummary     = "closed||discussion|summary"
+	discussionReopenedSummary   = "reopened||discussion|summary"
+	discussionCategory          = "Category"
+	discussionAnswerBy          = "Answer by"
+
+	reviewCommentCreated         = "created||review_comment"
+	reviewCommentEdited          = "edited||review_comment"
+	reviewCommentDeleted         = "deleted||review_comment"
+	reviewCommentReplied         = "created||review_comment|reply"
+	reviewCommentEditedReply     = "edited||review_comment|reply"
+	reviewCommentDeletedReply    = "deleted||review_comment|reply"
+	reviewCommentCreatedSummary  = "created||review_comment|summary"
+	reviewCommentEditedSummary   = "edited||review_comment|summary"
+	reviewCommentDeletedSummary  = "deleted||review_comment|summary"
+	reviewCommentRepliedSummary  = "created||review_comment|reply|summary"
+	reviewCommentEditedReplySum  = "edited||review_comment|reply|summary"
+	reviewCommentDeletedReplySum = "deleted||review_comment|reply|summary"
+	reviewCommentFile            = "File"
+
+	releasePublished          = "published||release"
+	releasePrereleased        = "prereleased||release"
+	releaseEdited             = "edited||release"
+	releaseDeleted            = "deleted||release"
+	releasePublishedSummary   = "published||release|summary"
+	releasePrereleasedSummary = "prereleased||release|summary"
+	releaseEditedSummary      = "edited||release|summary"
+	releaseDeletedSummary     = "deleted||release|summary"
+	releaseAuthor             = "Author"
+
 	branchPushed      = "pushed"
 	branchForced      = "forced"
 	branchPushText    = "pushed||branch"
@@ -60,10 +214,185 @@ const (
 	jobFailure         = "failure||job"
 	jobCancelled       = "cancelled||job"
 	jobSkipped         = "skipped||job"
+	jobTimedOut        = "timed_out||job"
+	jobPending         = "pending||job"
 	jobSuccessSymbol   = "success||job|sym"
 	jobFailureSymbol   = "failure||job|sym"
 	jobCancelledSymbol = "cancelled||job|sym"
 	jobSkippedSymbol   = "skipped||job|sym"
+	jobTimedOutSymbol  = "timed_out||job|sym"
+	jobPendingSymbol   = "pending||job|sym"
+
+	workflowDuration     = "Duration"
+	workflowAttempt      = "Attempt"
+	workflowTriggeredBy  = "Triggered by"
+	workflowPullRequests = "Pull requests"
+	statusChecks         = "Status checks"
+
+	triggerManual   = "workflow_dispatch||trigger"
+	triggerSchedule = "schedule||trigger"
+	triggerDispatch = "repository_dispatch||trigger"
+	triggerCron     = "Schedule"
+
+	deploymentQueued           = "queued||deployment"
+	deploymentPending          = "pending||deployment"
+	deploymentInProgress       = "in_progress||deployment"
+	deploymentSuccess          = "success||deployment"
+	deploymentFailure          = "failure||deployment"
+	deploymentError            = "error||deployment"
+	deploymentInactive         = "inactive||deployment"
+	deploymentQueuedSymbol     = "queued||deployment|sym"
+	deploymentPendingSymbol    = "pending||deployment|sym"
+	deploymentInProgressSymbol = "in_progress||deployment|sym"
+	deploymentSuccessSymbol    = "success||deployment|sym"
+	deploymentFailureSymbol    = "failure||deployment|sym"
+	deploymentErrorSymbol      = "error||deployment|sym"
+	deploymentInactiveSymbol   = "inactive||deployment|sym"
+	deploymentCommit           = "Commit"
+	deploymentCreator          = "Creator"
+
+	repoCreated           = "created||repository"
+	repoDeleted           = "deleted||repository"
+	repoArchived          = "archived||repository"
+	repoUnarchived        = "unarchived||repository"
+	repoRenamed           = "renamed||repository"
+	repoTransferred       = "transferred||repository"
+	repoPublicized        = "publicized||repository"
+	repoPrivatized        = "privatized||repository"
+	repoCreatedSummary    = "created||repository|summary"
+	repoDeletedSummary    = "deleted||repository|summary"
+	repoArchivedSummary   = "archived||repository|summary"
+	repoUnarchivedSummary = "unarchived||repository|summary"
+	repoRenamedSummary    = "renamed||repository|summary"
+	repoTransferredSum    = "transferred||repository|summary"
+	repoPublicizedSummary = "publicized||repository|summary"
+	repoPrivatizedSummary = "privatized||repository|summary"
+	repoPreviousName      = "Previous name"
+	repoPreviousOwner     = "Previous owner"
+	memberAdded           = "added||member"
+	memberRemoved         = "removed||member"
+	memberEdited          = "edited||member"
+	memberAddedSummary    = "added||member|summary"
+	memberRemovedSummary  = "removed||member|summary"
+	memberEditedSummary   = "edited||member|summary"
+	memberPermission      = "Permission"
+	protectionCreated     = "created||branch_protection"
+	protectionEdited      = "edited||branch_protection"
+	protectionDeleted     = "deleted||branch_protection"
+	protectionCreatedSum  = "created||branch_protection|summary"
+	protectionEditedSum   = "edited||branch_protection|summary"
+	protectionDeletedSum  = "deleted||branch_protection|summary"
+	protectionChanged     = "Changed"
+	starCreated           = "created||star"
+	starDeleted           = "deleted||star"
+	starCreatedSummary    = "created||star|summary"
+	starDeletedSummary    = "deleted||star|summary"
+
+	alertCodeScanning     = "code_scanning||alert"
+	alertSecretScanning   = "secret_scanning||alert"
+	alertDependabot       = "dependabot||alert"
+	alertCreated          = "created||alert"
+	alertReopened         = "reopened||alert"
+	alertReintroduced     = "reintroduced||alert"
+	alertAppearedInBranch = "appeared_in_branch||alert"
+	alertFixed            = "fixed||alert"
+	alertResolved         = "resolved||alert"
+	alertDismissed        = "dismissed||alert"
+	alertAutoDismissed    = "auto_dismissed||alert"
+	alertRevoked          = "revoked||alert"
+	alertSeverity         = "Severity"
+	alertRule             = "Rule"
+	alertTool             = "Tool"
+	alertLocation         = "Location"
+	alertSecretType       = "Secret type"
+	alertAdvisory         = "Advisory"
+	alertCVE              = "CVE"
+	alertPackage          = "Package"
+	alertVulnerable       = "Vulnerable versions"
+	alertPatched          = "Patched in"
+	alertManifest         = "Manifest"
+	alertResolution       = "Resolution"
+
+	viewMilestone          = "View Milestone"
+	viewLabels             = "View Labels"
+	viewProject            = "View Project"
+	msgUserVerbedMilestone = "%#+s %m %#+s"
+	msgUserVerbedLabel     = "%#+s %m %#+s"
+	msgUserSetItemField    = "%#+s set %#+s of %m to %#+s"
+	msgVerbedMilestoneSum  = "verbed||milestone|summary"
+	msgVerbedLabelSum      = "verbed||label|summary"
+	msgSetItemFieldSummary = "set||project_item|summary"
+
+	milestoneCreated        = "created||milestone"
+	milestoneClosed         = "closed||milestone"
+	milestoneOpened         = "opened||milestone"
+	milestoneEdited         = "edited||milestone"
+	milestoneDeleted        = "deleted||milestone"
+	milestoneCreatedSummary = "created||milestone|summary"
+	milestoneClosedSummary  = "closed||milestone|summary"
+	milestoneOpenedSummary  = "opened||milestone|summary"
+	milestoneEditedSummary  = "edited||milestone|summary"
+	milestoneDeletedSummary = "deleted||milestone|summary"
+	milestoneOpenIssues     = "Open issues"
+	milestoneClosedIssues   = "Closed issues"
+	milestoneDue            = "Due"
+	milestoneNoDue          = "none||milestone|due"
+	milestoneDescription    = "Description"
+	labelCreated            = "created||label"
+	labelEdited             = "edited||label"
+	labelDeleted            = "deleted||label"
+	labelCreatedSummary     = "created||label|summary"
+	labelEditedSummary      = "edited||label|summary"
+	labelDeletedSummary     = "deleted||label|summary"
+	labelColor              = "Color"
+	labelPreviousName       = "Previous name||label"
+	labelDescription        = "Description||label"
+
+	itemIssue            = "Issue||project_item"
+	itemPullRequest      = "PullRequest||project_item"
+	itemDraftIssue       = "DraftIssue||project_item"
+	itemCreated          = "created||project_item"
+	itemDeleted          = "deleted||project_item"
+	itemArchived         = "archived||project_item"
+	itemRestored         = "restored||project_item"
+	itemConverted        = "converted||project_item"
+	itemCreatedSummary   = "created||project_item|summary"
+	itemDeletedSummary   = "deleted||project_item|summary"
+	itemArchivedSummary  = "archived||project_item|summary"
+	itemRestoredSummary  = "restored||project_item|summary"
+	itemConvertedSummary = "converted||project_item|summary"
+	projectNumber        = "Project"
+
+	viewPackage             = "View Package"
+	viewSettings            = "View Settings"
+	msgUserVerbedPackage    = "%#+s %m %#+s %#+s"
+	msgVerbedPackageSummary = "verbed||package|summary"
+	msgPageBuild            = "status||pages"
+	msgPageBuildSummary     = "status||pages|summary"
+	packagePublished        = "published||package"
+	packageUpdated          = "updated||package"
+	packagePublishedSummary = "published||package|summary"
+	packageUpdatedSummary   = "updated||package|summary"
+	packageEcosystem        = "Ecosystem"
+	pagesSuccess            = "success||pages"
+	pagesFailure            = "failure||pages"
+
+	mergeGroupRequested    = "checks_requested||merge_group"
+	mergeGroupMerged       = "destroyed||merge_group|merged"
+	mergeGroupInvalidated  = "destroyed||merge_group|invalidated"
+	mergeGroupDequeued     = "destroyed||merge_group|dequeued"
+	mergeGroupRequestedSum = "checks_requested||merge_group|summary"
+	mergeGroupMergedSum    = "destroyed||merge_group|merged|summary"
+	mergeGroupInvalidSum   = "destroyed||merge_group|invalidated|summary"
+	mergeGroupDequeuedSum  = "destroyed||merge_group|dequeued|summary"
+	mergeGroupHead         = "Head"
+	mergeGroupReason       = "Reason"
+	mergeGroupUnnamed      = "a merge group"
+	triggerMergeGroup      = "merge_group||trigger"
+
+	msgUserUnknownEvent = "%#+s triggered a %#+s event"
+	msgUnknownSummary   = "unknown||summary"
+	unknownAction       = "Action"
 )
 
 func init() {
@@ -80,11 +409,100 @@ func init() {
 	_ = message.SetString(language.English, prOpened, "opened pull request")
 	_ = message.SetString(language.English, prOpenedDraft, "opened draft pull request")
 	_ = message.SetString(language.English, prClosed, "closed pull request")
+	_ = message.SetString(language.English, prClosedDraft, "closed draft pull request")
 	_ = message.SetString(language.English, prClosedMerged, "merged pull request")
+	_ = message.SetString(language.English, prReopened, "reopened pull request")
+	_ = message.SetString(language.English, prReopenedDraft, "reopened draft pull request")
+	_ = message.SetString(language.English, prEdited, "edited pull request")
+	_ = message.SetString(language.English, prLabeled, "labeled pull request")
+	_ = message.SetString(language.English, prUnlabeled, "unlabeled pull request")
+	_ = message.SetString(language.English, prAssigned, "assigned pull request")
+	_ = message.SetString(language.English, prAutoMergeEnabled, "enabled auto-merge for")
+	_ = message.SetString(language.English, prAutoMergeDisabled, "disabled auto-merge for")
 	_ = message.SetString(language.English, prOpenedSummary, "opened PR")
 	_ = message.SetString(language.English, prOpenedDraftSummary, "opened draft PR")
 	_ = message.SetString(language.English, prClosedSummary, "closed PR")
+	_ = message.SetString(language.English, prClosedDraftSummary, "closed draft PR")
 	_ = message.SetString(language.English, prClosedMergedSummary, "merged PR")
+	_ = message.SetString(language.English, prReopenedSummary, "reopened PR")
+	_ = message.SetString(language.English, prReopenedDraftSum, "reopened draft PR")
+	_ = message.SetString(language.English, prReadySummary, "undrafted PR")
+	_ = message.SetString(language.English, prDraftedSummary, "drafted PR")
+	_ = message.SetString(language.English, prSyncedSummary, "updated PR")
+	_ = message.SetString(language.English, prEditedSummary, "edited PR")
+	_ = message.SetString(language.English, prLabeledSummary, "labeled PR")
+	_ = message.SetString(language.English, prUnlabeledSummary, "unlabeled PR")
+	_ = message.SetString(language.English, prAssignedSummary, "assigned PR")
+	_ = message.SetString(language.English, prReviewReqSummary, "requested review of PR")
+	_ = message.SetString(language.English, prAutoMergeOnSummary, "enabled auto-merge for PR")
+	_ = message.SetString(language.English, prAutoMergeOffSummary, "disabled auto-merge for PR")
+	_ = message.Set(language.English, msgUserSyncedPR, plural.Selectf(4, "%d",
+		plural.One, "%#+s updated #%#d: %#+s (%d commit in total)",
+		plural.Other, "%#+s updated #%#d: %#+s (%d commits in total)"))
+	_ = message.SetString(language.English, issueOpened, "opened issue")
+	_ = message.SetString(language.English, issueClosed, "closed issue")
+	_ = message.SetString(language.English, issueClosedNotPlanned, "closed (not planned) issue")
+	_ = message.SetString(language.English, issueReopened, "reopened issue")
+	_ = message.SetString(language.English, issueLabeled, "labeled issue")
+	_ = message.SetString(language.English, issueAssigned, "assigned issue")
+	_ = message.SetString(language.English, issueTransferred, "transferred issue")
+	_ = message.SetString(language.English, issueOpenedSummary, "opened issue")
+	_ = message.SetString(language.English, issueClosedSummary, "closed issue")
+	_ = message.SetString(language.English, issueClosedNotPlannedSum, "closed issue")
+	_ = message.SetString(language.English, issueReopenedSummary, "reopened issue")
+	_ = message.SetString(language.English, issueLabeledSummary, "labeled issue")
+	_ = message.SetString(language.English, issueAssignedSummary, "assigned issue")
+	_ = message.SetString(language.English, issueTransferredSummary, "transferred issue")
+	_ = message.SetString(language.English, commentCreatedIssue, "commented on issue")
+	_ = message.SetString(language.English, commentEditedIssue, "edited a comment on issue")
+	_ = message.SetString(language.English, commentDeletedIssue, "deleted a comment on issue")
+	_ = message.SetString(language.English, commentCreatedPR, "commented on pull request")
+	_ = message.SetString(language.English, commentEditedPR, "edited a comment on pull request")
+	_ = message.SetString(language.English, commentDeletedPR, "deleted a comment on pull request")
+	_ = message.SetString(language.English, commentCreatedIssueSummary, "commented on")
+	_ = message.SetString(language.English, commentEditedIssueSummary, "edited comment on")
+	_ = message.SetString(language.English, commentDeletedIssueSummary, "deleted comment on")
+	_ = message.SetString(language.English, commentCreatedDisc, "commented on discussion")
+	_ = message.SetString(language.English, commentEditedDisc, "edited a comment on discussion")
+	_ = message.SetString(language.English, commentDeletedDisc, "deleted a comment on discussion")
+	_ = message.SetString(language.English, commentCreatedDiscSummary, "commented on discussion")
+	_ = message.SetString(language.English, commentEditedDiscSummary, "edited comment on discussion")
+	_ = message.SetString(language.English, commentDeletedDiscSummary, "deleted comment on discussion")
+	_ = message.SetString(language.English, discussionCreated, "started discussion")
+	_ = message.SetString(language.English, discussionAnswered, "marked an answer for discussion")
+	_ = message.SetString(language.English, discussionUnanswered, "unmarked the answer for discussion")
+	_ = message.SetString(language.English, discussionCategoryChanged, "recategorized discussion")
+	_ = message.SetString(language.English, discussionClosed, "closed discussion")
+	_ = message.SetString(language.English, discussionReopened, "reopened discussion")
+	_ = message.SetString(language.English, discussionCreatedSummary, "started discussion")
+	_ = message.SetString(language.English, discussionAnsweredSummary, "answered discussion")
+	_ = message.SetString(language.English, discussionUnansweredSummary, "unmarked answer of discussion")
+	_ = message.SetString(language.English, discussionCategorySummary, "recategorized discussion")
+	_ = message.SetString(language.English, discussionClosedSummary, "closed discussion")
+	_ = message.SetString(language.English, discussionReopenedSummary, "reopened discussion")
+	_ = message.SetString(language.English, commentCreatedPRSummary, "commented on PR")
+	_ = message.SetString(language.English, commentEditedPRSummary, "edited comment on PR")
+	_ = message.SetString(language.English, commentDeletedPRSummary, "deleted comment on PR")
+	_ = message.SetString(language.English, reviewCommentCreated, "commented on the code in pull request")
+	_ = message.SetString(language.English, reviewCommentEdited, "edited a code comment in pull request")
+	_ = message.SetString(language.English, reviewCommentDeleted, "deleted a code comment in pull request")
+	_ = message.SetString(language.English, reviewCommentReplied, "replied to a code comment in pull request")
+	_ = message.SetString(language.English, reviewCommentEditedReply, "edited a code comment reply in pull request")
+	_ = message.SetString(language.English, reviewCommentDeletedReply, "deleted a code comment reply in pull request")
+	_ = message.SetString(language.English, reviewCommentCreatedSummary, "commented on")
+	_ = message.SetString(language.English, reviewCommentEditedSummary, "edited comment on")
+	_ = message.SetString(language.English, reviewCommentDeletedSummary, "deleted comment on")
+	_ = message.SetString(language.English, reviewCommentRepliedSummary, "replied on")
+	_ = message.SetString(language.English, reviewCommentEditedReplySum, "edited reply on")
+	_ = message.SetString(language.English, reviewCommentDeletedReplySum, "deleted reply on")
+	_ = message.SetString(language.English, releasePublished, "published release")
+	_ = message.SetString(language.English, releasePrereleased, "published pre-release")
+	_ = message.SetString(language.English, releaseEdited, "edited release")
+	_ = message.SetString(language.English, releaseDeleted, "deleted release")
+	_ = message.SetString(language.English, releasePublishedSummary, "released")
+	_ = message.SetString(language.English, releasePrereleasedSummary, "pre-released")
+	_ = message.SetString(language.English, releaseEditedSummary, "edited release")
+	_ = message.SetString(language.English, releaseDeletedSummary, "deleted release")
 	_ = message.SetString(language.English, createTag, "%s tagged %s")
 	_ = message.SetString(language.English, deleteBranch, "%s deleted %s")
 	_ = message.SetString(language.English, deleteTag, "%s untagged %s")
@@ -92,12 +510,148 @@ func init() {
 	_ = message.SetString(language.English, msgReviewedPR, "%s reviewed #%#d")
 	_ = message.SetString(language.English, msgCommentedPR, "%s commented on #%#d")
 	_ = message.SetString(language.English, msgEditedReview, "%s edited #%#d review")
+	_ = message.SetString(language.English, msgVerbedRelease, "%s %m %s")
+	_ = message.SetString(language.English, msgVerbedIssue, "%s %m #%#d")
+	_ = message.SetString(language.English, msgVerbedDiscussion, "%s %m #%#d")
+	_ = message.SetString(language.English, msgVerbedComment, "%s %m #%#d")
+	_ = message.SetString(language.English, msgVerbedReviewComment, "%s %m #%#d")
 	_ = message.SetString(language.English, jobSuccess, "passed")
 	_ = message.SetString(language.English, jobFailure, "failed")
 	_ = message.SetString(language.English, jobCancelled, "was cancelled")
 	_ = message.SetString(language.English, jobSkipped, "was skipped")
+	_ = message.SetString(language.English, jobTimedOut, "timed out")
+	_ = message.SetString(language.English, jobPending, "is still running")
+	_ = message.SetString(language.English, msgWorkflowStatusTrigger, "%m %#s %m for %#+s (%m)")
+	_ = message.SetString(language.English, msgStartedSummary, "%s started %s")
+	_ = message.SetString(language.English, msgScheduledSummary, "%s started on schedule")
+	_ = message.SetString(language.English, msgDispatchedSummary, "%s dispatched %s")
+	_ = message.SetString(language.English, triggerManual, "manual")
+	_ = message.SetString(language.English, triggerSchedule, "scheduled")
+	_ = message.SetString(language.English, triggerDispatch, "repository dispatch")
+	_ = message.SetString(language.English, msgDeployingSummary, "%s deploying %s to %s")
+	_ = message.SetString(language.English, msgDeploymentStatusSummary, "%s to %s %m")
+	_ = message.SetString(language.English, deploymentQueued, "is queued")
+	_ = message.SetString(language.English, deploymentPending, "is pending")
+	_ = message.SetString(language.English, deploymentInProgress, "is in progress")
+	_ = message.SetString(language.English, deploymentSuccess, "succeeded")
+	_ = message.SetString(language.English, deploymentFailure, "failed")
+	_ = message.SetString(language.English, deploymentError, "errored")
+	_ = message.SetString(language.English, deploymentInactive, "is inactive")
+	_ = message.SetString(language.English, deploymentQueuedSymbol, "⏳")
+	_ = message.SetString(language.English, deploymentPendingSymbol, "⏳")
+	_ = message.SetString(language.English, deploymentInProgressSymbol, "🚀")
+	_ = message.SetString(language.English, deploymentSuccessSymbol, "✔")
+	_ = message.SetString(language.English, deploymentFailureSymbol, "❌")
+	_ = message.SetString(language.English, deploymentErrorSymbol, "❌")
+	_ = message.SetString(language.English, deploymentInactiveSymbol, "◌")
+	_ = message.SetString(language.English, msgVerbedRepoSummary, "%s %m %s")
+	_ = message.SetString(language.English, msgVerbedMemberSummary, "%s %m %s")
+	_ = message.SetString(language.English, msgVerbedProtectionSummary, "%s %m %s")
+	_ = message.SetString(language.English, msgForkedSummary, "%s forked %s")
+	_ = message.SetString(language.English, repoCreated, "created repository")
+	_ = message.SetString(language.English, repoDeleted, "deleted repository")
+	_ = message.SetString(language.English, repoArchived, "archived repository")
+	_ = message.SetString(language.English, repoUnarchived, "unarchived repository")
+	_ = message.SetString(language.English, repoRenamed, "renamed repository to")
+	_ = message.SetString(language.English, repoTransferred, "transferred repository to")
+	_ = message.SetString(language.English, repoPublicized, "made public")
+	_ = message.SetString(language.English, repoPrivatized, "made private")
+	_ = message.SetString(language.English, repoCreatedSummary, "created")
+	_ = message.SetString(language.English, repoDeletedSummary, "deleted")
+	_ = message.SetString(language.English, repoArchivedSummary, "archived")
+	_ = message.SetString(language.English, repoUnarchivedSummary, "unarchived")
+	_ = message.SetString(language.English, repoRenamedSummary, "renamed to")
+	_ = message.SetString(language.English, repoTransferredSum, "transferred")
+	_ = message.SetString(language.English, repoPublicizedSummary, "made public")
+	_ = message.SetString(language.English, repoPrivatizedSummary, "made private")
+	_ = message.SetString(language.English, memberAdded, "added collaborator")
+	_ = message.SetString(language.English, memberRemoved, "removed collaborator")
+	_ = message.SetString(language.English, memberEdited, "changed permissions of")
+	_ = message.SetString(language.English, memberAddedSummary, "added")
+	_ = message.SetString(language.English, memberRemovedSummary, "removed")
+	_ = message.SetString(language.English, memberEditedSummary, "changed permissions of")
+	_ = message.SetString(language.English, protectionCreated, "added branch protection")
+	_ = message.SetString(language.English, protectionEdited, "changed branch protection")
+	_ = message.SetString(language.English, protectionDeleted, "removed branch protection")
+	_ = message.SetString(language.English, protectionCreatedSum, "protected")
+	_ = message.SetString(language.English, protectionEditedSum, "changed protection of")
+	_ = message.SetString(language.English, protectionDeletedSum, "unprotected")
+	_ = message.SetString(language.English, starCreated, "starred")
+	_ = message.SetString(language.English, starDeleted, "unstarred")
+	_ = message.SetString(language.English, starCreatedSummary, "starred")
+	_ = message.SetString(language.English, starDeletedSummary, "unstarred")
+	_ = message.SetString(language.English, msgAlertSummary, "%m #%d %m")
+	_ = message.SetString(language.English, alertCodeScanning, "Code scanning alert")
+	_ = message.SetString(language.English, alertSecretScanning, "Secret scanning alert")
+	_ = message.SetString(language.English, alertDependabot, "Dependabot alert")
+	_ = message.SetString(language.English, alertCreated, "opened")
+	_ = message.SetString(language.English, alertReopened, "reopened")
+	_ = message.SetString(language.English, alertReintroduced, "reintroduced")
+	_ = message.SetString(language.English, alertAppearedInBranch, "appeared in another branch")
+	_ = message.SetString(language.English, alertFixed, "fixed")
+	_ = message.SetString(language.English, alertResolved, "resolved")
+	_ = message.SetString(language.English, alertDismissed, "dismissed")
+	_ = message.SetString(language.English, alertAutoDismissed, "auto-dismissed")
+	_ = message.SetString(language.English, alertRevoked, "revoked")
 	_ = message.SetString(language.English, jobSuccessSymbol, "✔")
 	_ = message.SetString(language.English, jobFailureSymbol, "❌")
 	_ = message.SetString(language.English, jobCancelledSymbol, "🚫")
 	_ = message.SetString(language.English, jobSkippedSymbol, "◌")
+	_ = message.SetString(language.English, jobTimedOutSymbol, "⌛")
+	_ = message.SetString(language.English, jobPendingSymbol, "⏳")
+	_ = message.SetString(language.English, msgVerbedMilestoneSum, "%s %m %s")
+	_ = message.SetString(language.English, msgVerbedLabelSum, "%s %m %s")
+	_ = message.SetString(language.English, msgSetItemFieldSummary, "%s set %s of %m to %s")
+	_ = message.SetString(language.English, milestoneCreated, "created milestone")
+	_ = message.SetString(language.English, milestoneClosed, "closed milestone")
+	_ = message.SetString(language.English, milestoneOpened, "reopened milestone")
+	_ = message.SetString(language.English, milestoneEdited, "edited milestone")
+	_ = message.SetString(language.English, milestoneDeleted, "deleted milestone")
+	_ = message.SetString(language.English, milestoneCreatedSummary, "created milestone")
+	_ = message.SetString(language.English, milestoneClosedSummary, "closed milestone")
+	_ = message.SetString(language.English, milestoneOpenedSummary, "reopened milestone")
+	_ = message.SetString(language.English, milestoneEditedSummary, "edited milestone")
+	_ = message.SetString(language.English, milestoneDeletedSummary, "deleted milestone")
+	_ = message.SetString(language.English, milestoneNoDue, "none")
+	_ = message.SetString(language.English, labelCreated, "created label")
+	_ = message.SetString(language.English, labelEdited, "edited label")
+	_ = message.SetString(language.English, labelDeleted, "deleted label")
+	_ = message.SetString(language.English, labelCreatedSummary, "created label")
+	_ = message.SetString(language.English, labelEditedSummary, "edited label")
+	_ = message.SetString(language.English, labelDeletedSummary, "deleted label")
+	_ = message.SetString(language.English, labelPreviousName, "Previous name")
+	_ = message.SetString(language.English, labelDescription, "Description")
+	_ = message.SetString(language.English, itemIssue, "an issue")
+	_ = message.SetString(language.English, itemPullRequest, "a pull request")
+	_ = message.SetString(language.English, itemDraftIssue, "a draft issue")
+	_ = message.SetString(language.English, itemCreated, "%#+s added %m to a project")
+	_ = message.SetString(language.English, itemDeleted, "%#+s removed %m from a project")
+	_ = message.SetString(language.English, itemArchived, "%#+s archived %m in a project")
+	_ = message.SetString(language.English, itemRestored, "%#+s restored %m in a project")
+	_ = message.SetString(language.English, itemConverted, "%#+s converted %m to an issue")
+	_ = message.SetString(language.English, itemCreatedSummary, "%s added %m to a project")
+	_ = message.SetString(language.English, itemDeletedSummary, "%s removed %m from a project")
+	_ = message.SetString(language.English, itemArchivedSummary, "%s archived %m in a project")
+	_ = message.SetString(language.English, itemRestoredSummary, "%s restored %m in a project")
+	_ = message.SetString(language.English, itemConvertedSummary, "%s converted %m to an issue")
+	_ = message.SetString(language.English, msgVerbedPackageSummary, "%s %m %s %s")
+	_ = message.SetString(language.English, msgPageBuild, "%m GitHub Pages build %m")
+	_ = message.SetString(language.English, msgPageBuildSummary, "Pages build %m")
+	_ = message.SetString(language.English, packagePublished, "published package")
+	_ = message.SetString(language.English, packageUpdated, "updated package")
+	_ = message.SetString(language.English, packagePublishedSummary, "published")
+	_ = message.SetString(language.English, packageUpdatedSummary, "updated")
+	_ = message.SetString(language.English, pagesSuccess, "succeeded")
+	_ = message.SetString(language.English, pagesFailure, "failed")
+	_ = message.SetString(language.English, mergeGroupRequested, "Merge queue is checking %s into %#+s")
+	_ = message.SetString(language.English, mergeGroupMerged, "Merge queue merged %s into %#+s")
+	_ = message.SetString(language.English, mergeGroupInvalidated, "Merge queue group for %s into %#+s was invalidated")
+	_ = message.SetString(language.English, mergeGroupDequeued, "Merge queue group for %s into %#+s was dequeued")
+	_ = message.SetString(language.English, mergeGroupRequestedSum, "Merge queue checking %s into %s")
+	_ = message.SetString(language.English, mergeGroupMergedSum, "Merge queue merged %s into %s")
+	_ = message.SetString(language.English, mergeGroupInvalidSum, "Merge queue invalidated %s into %s")
+	_ = message.SetString(language.English, mergeGroupDequeuedSum, "Merge queue dequeued %s into %s")
+	_ = message.SetString(language.English, mergeGroupUnnamed, "a merge group")
+	_ = message.SetString(language.English, triggerMergeGroup, "merge queue")
+	_ = message.SetString(language.English, msgUnknownSummary, "%s triggered %s")
 }
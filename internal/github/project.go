@@ -0,0 +1,228 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/text/message"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+// Project management events: milestones, labels, and project board items.
+
+func init() {
+	register("label", func() eventer { return &Label{} })
+	register("milestone", func() eventer { return &Milestone{} })
+	register("projects_v2_item", func() eventer { return &ProjectsV2Item{} })
+}
+
+type Milestone struct {
+	Common
+	Milestone struct {
+		Title        string
+		Description  string
+		URL          string `json:"html_url"`
+		OpenIssues   int    `json:"open_issues"`
+		ClosedIssues int    `json:"closed_issues"`
+		DueOn        string `json:"due_on"`
+	}
+	Changes struct {
+		Title       struct{ From string }
+		Description struct{ From string }
+		DueOn       *struct{ From string } `json:"due_on"`
+	}
+}
+
+func (ev Milestone) Event(p *message.Printer) *event.Detail {
+	switch ev.Action {
+	case "created", "closed", "opened", "edited", "deleted":
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	milestone := ev.Milestone
+	verb := ev.Action + "||milestone"
+	facts := []event.Fact{
+		{Name: p.Sprint(milestoneOpenIssues), Value: p.Sprint(milestone.OpenIssues)},
+		{Name: p.Sprint(milestoneClosedIssues), Value: p.Sprint(milestone.ClosedIssues)},
+	}
+
+	due := dueDate(p, milestone.DueOn)
+	switch {
+	case ev.Changes.DueOn != nil:
+		facts = append(facts, event.Fact{Name: p.Sprint(milestoneDue), Value: p.Sprintf(msgChangedFromTo, md(dueDate(p, ev.Changes.DueOn.From)), md(due))})
+	case ev.Action == "created" && milestone.DueOn != "":
+		facts = append(facts, event.Fact{Name: p.Sprint(milestoneDue), Value: p.Sprintf("%#s", md(due))})
+	}
+	if from := ev.Changes.Title.From; from != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(prPreviousTitle), Value: p.Sprintf("%#s", md(from))})
+	}
+	if ev.Changes.Description.From != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(milestoneDescription), Value: p.Sprintf("%#s", md(milestone.Description))})
+	}
+
+	var view []event.Action
+	if ev.Action != "deleted" {
+		view = []event.Action{{Name: p.Sprint(viewMilestone), URL: milestone.URL}}
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedMilestoneSum, "%s %m %s"), username, verb+"|summary", md(milestone.Title)),
+		Text:    p.Sprintf(msgUserVerbedMilestone, username, verb, md(milestone.Title)),
+		Fact:    facts,
+		Action:  view,
+	})
+}
+
+// dueDate formats a milestone's due date, which GitHub sends as a timestamp
+// even though only the day is meaningful.
+func dueDate(p *message.Printer, due string) string {
+	if due == "" {
+		return p.Sprint(milestoneNoDue)
+	}
+	t, err := time.Parse(time.RFC3339, due)
+	if err != nil {
+		return due
+	}
+	return t.Format("2006-01-02")
+}
+
+type Label struct {
+	Common
+	Label struct {
+		Name        string
+		Color       string
+		Description string
+	}
+	Changes struct {
+		Name        struct{ From string }
+		Color       struct{ From string }
+		Description struct{ From string }
+	}
+}
+
+func (ev Label) Event(p *message.Printer) *event.Detail {
+	switch ev.Action {
+	case "created", "edited", "deleted":
+	default:
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	label := ev.Label
+	verb := ev.Action + "||label"
+	var facts []event.Fact
+	if from := ev.Changes.Name.From; from != "" {
+		facts = append(facts, event.Fact{Name: p.Sprintf(labelPreviousName), Value: p.Sprintf("%#s", md(from))})
+	}
+	if from := ev.Changes.Color.From; from != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(labelColor), Value: p.Sprintf(msgChangedFromTo, md("#"+from), md("#"+label.Color))})
+	} else if ev.Action == "created" {
+		facts = append(facts, event.Fact{Name: p.Sprint(labelColor), Value: p.Sprintf("%#s", md("#"+label.Color))})
+	}
+	if label.Description != "" && (ev.Action == "created" || ev.Changes.Description.From != "") {
+		facts = append(facts, event.Fact{Name: p.Sprintf(labelDescription), Value: p.Sprintf("%#s", md(label.Description))})
+	}
+
+	color := ""
+	if label.Color != "" && ev.Action != "deleted" {
+		color = "#" + label.Color
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		ThemeColor: color,
+		Summary:    p.Sprintf(message.Key(msgVerbedLabelSum, "%s %m %s"), username, verb+"|summary", md(label.Name)),
+		Text:       p.Sprintf(msgUserVerbedLabel, username, verb, md(label.Name)),
+		Fact:       facts,
+		Action:     []event.Action{{Name: p.Sprint(viewLabels), URL: ev.Repository.URL + "/labels"}},
+	})
+}
+
+// ProjectsV2Item reports changes to items on an organization's project board.
+// The payload only identifies the item and project by node ID, so all we can
+// say is what kind of item it was and which field changed.
+type ProjectsV2Item struct {
+	Common
+	ProjectsV2Item struct {
+		ContentType string `json:"content_type"`
+	} `json:"projects_v2_item"`
+	Changes struct {
+		FieldValue struct {
+			FieldName     string `json:"field_name"`
+			ProjectNumber int    `json:"project_number"`
+			From          json.RawMessage
+			To            json.RawMessage
+		} `json:"field_value"`
+	}
+}
+
+func (ev ProjectsV2Item) Event(p *message.Printer) *event.Detail {
+	username := md(ev.Sender.Login)
+	content := ev.ProjectsV2Item.ContentType + "||project_item"
+	change := ev.Changes.FieldValue
+
+	var facts []event.Fact
+	var text, summary string
+	switch ev.Action {
+	case "created", "deleted", "archived", "restored", "converted":
+		verb := ev.Action + "||project_item"
+		text = p.Sprintf(verb, username, content)
+		summary = p.Sprintf(verb+"|summary", username, content)
+	case "edited":
+		if change.FieldName == "" {
+			return nil
+		}
+		field, from, to := md(change.FieldName), md(fieldValue(change.From)), md(fieldValue(change.To))
+		text = p.Sprintf(msgUserSetItemField, username, field, content, to)
+		summary = p.Sprintf(message.Key(msgSetItemFieldSummary, "%s set %s of %m to %s"), username, field, content, to)
+		value := p.Sprintf("%#+s", to)
+		if from != "" {
+			value = p.Sprintf(msgChangedFromTo, from, to)
+		}
+		facts = append(facts, event.Fact{Name: p.Sprintf("%#s", field), Value: value})
+	default:
+		return nil
+	}
+
+	var view []event.Action
+	if org, number := ev.Organization.Login, change.ProjectNumber; org != "" && number != 0 {
+		facts = append(facts, event.Fact{Name: p.Sprint(projectNumber), Value: p.Sprintf("#%#d", number)})
+		view = []event.Action{{Name: p.Sprint(viewProject), URL: fmt.Sprintf("https://github.com/orgs/%s/projects/%d", org, number)}}
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Repository: ev.Organization.Login,
+		Summary:    summary,
+		Text:       text,
+		Fact:       facts,
+		Action:     view,
+	})
+}
+
+// fieldValue describes a project field's value: the name of a single select
+// option, the title of an iteration, the day of a date, or the value itself
+// for text and numbers.
+func fieldValue(raw json.RawMessage) string {
+	var option struct{ Name, Title string }
+	if err := json.Unmarshal(raw, &option); err == nil {
+		if option.Name != "" {
+			return option.Name
+		}
+		return option.Title
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t.Format("2006-01-02")
+		}
+		return s
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return ""
+	}
+	return string(raw)
+}
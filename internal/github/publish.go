@@ -0,0 +1,150 @@
+package github
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/text/message"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+// Publishing events: packages and GitHub Pages builds.
+
+func init() {
+	register("package", func() eventer { return &PackageEvent{} })
+	register("page_build", func() eventer { return &PageBuild{} })
+	register("registry_package", func() eventer { return &PackageEvent{} })
+}
+
+type Package struct {
+	Name        string
+	PackageType string `json:"package_type"`
+	URL         string `json:"html_url"`
+	Version     struct {
+		Version             string
+		URL                 string `json:"html_url"`
+		PackageURL          string `json:"package_url"`
+		InstallationCommand string `json:"installation_command"`
+		ContainerMetadata   struct {
+			Tag struct {
+				Name string
+			}
+		} `json:"container_metadata"`
+	} `json:"package_version"`
+}
+
+// PackageEvent covers both the package and registry_package webhooks, which
+// differ only in the name of the key holding the package.
+type PackageEvent struct {
+	Common
+	Package         *Package
+	RegistryPackage *Package `json:"registry_package"`
+}
+
+func (ev PackageEvent) Event(p *message.Printer) *event.Detail {
+	switch ev.Action {
+	case "published", "updated":
+	default:
+		return nil
+	}
+	pkg := ev.Package
+	if pkg == nil {
+		pkg = ev.RegistryPackage
+	}
+	if pkg == nil {
+		return nil
+	}
+
+	username := md(ev.Sender.Login)
+	verb := ev.Action + "||package"
+	version := pkg.Version.ContainerMetadata.Tag.Name
+	if version == "" {
+		version = pkg.Version.Version
+	}
+
+	var body string
+	if pull := pullCommand(pkg, version); pull != "" {
+		body = fmt.Sprintf("`%s`", pull)
+	}
+	url := pkg.Version.URL
+	if url == "" {
+		url = pkg.URL
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		Summary: p.Sprintf(message.Key(msgVerbedPackageSummary, "%s %m %s %s"), username, verb+"|summary", md(pkg.Name), md(version)),
+		Text:    p.Sprintf(msgUserVerbedPackage, username, verb, md(pkg.Name), md(version)),
+		Body:    body,
+		Fact:    []event.Fact{{Name: p.Sprint(packageEcosystem), Value: p.Sprintf("%#s", md(pkg.PackageType))}},
+		Action:  []event.Action{{Name: p.Sprint(viewPackage), URL: url}},
+	})
+}
+
+// pullCommand returns the command that fetches this version of pkg, preferring
+// the one GitHub suggests.
+func pullCommand(pkg *Package, version string) string {
+	if cmd := pkg.Version.InstallationCommand; cmd != "" {
+		return cmd
+	}
+	switch pkg.PackageType {
+	case "container", "docker", "CONTAINER", "DOCKER":
+		if pkg.Version.PackageURL != "" {
+			return "docker pull " + pkg.Version.PackageURL
+		}
+	case "npm", "NPM":
+		return fmt.Sprintf("npm install %s@%s", pkg.Name, version)
+	case "rubygems", "RUBYGEMS":
+		return fmt.Sprintf("gem install %s --version %s", pkg.Name, version)
+	case "nuget", "NUGET":
+		return fmt.Sprintf("dotnet add package %s --version %s", pkg.Name, version)
+	}
+	return ""
+}
+
+type PageBuild struct {
+	Common
+	Build struct {
+		Status string
+		Error  struct {
+			Message string
+		}
+		Commit   string
+		Duration int // milliseconds
+	}
+}
+
+func (ev PageBuild) Event(p *message.Printer) *event.Detail {
+	var status, color string
+	switch ev.Build.Status {
+	case "built":
+		status, color = "success", themeSuccess
+	case "errored":
+		status, color = "failure", themeFailure
+	default: // building, null
+		return nil
+	}
+
+	var body string
+	if msg := ev.Build.Error.Message; msg != "" {
+		body = p.Sprintf("%#s", md(msg))
+	}
+
+	var facts []event.Fact
+	if sha := ev.Build.Commit; len(sha) >= 9 {
+		facts = append(facts, event.Fact{Name: p.Sprint(deploymentCommit), Value: fmt.Sprintf("[%s](%s/commit/%s)", sha[:9], ev.Repository.URL, sha)})
+	}
+	if ev.Build.Duration > 0 {
+		duration := time.Duration(ev.Build.Duration) * time.Millisecond
+		facts = append(facts, event.Fact{Name: p.Sprint(workflowDuration), Value: duration.Round(time.Second).String()})
+	}
+
+	return fillEvent(p, ev.Common, event.Detail{
+		ThemeColor: color,
+		Summary:    p.Sprintf(message.Key(msgPageBuildSummary, "Pages build %m"), status+"||pages"),
+		Text:       p.Sprintf(message.Key(msgPageBuild, "%m GitHub Pages build %m"), status+"||job|sym", status+"||pages"),
+		Body:       body,
+		Fact:       facts,
+		Action:     []event.Action{{Name: p.Sprint(viewSettings), URL: ev.Repository.URL + "/settings/pages"}},
+	})
+}
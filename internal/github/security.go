@@ -0,0 +1,286 @@
+package github
+
+import (
+	"fmt"
+
+	"golang.org/x/text/message"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+// Security alerts share one layout: the alert kind, number and title, what
+// happened to it, and facts for severity, identifiers and location.
+
+func init() {
+	register("code_scanning_alert", func() eventer { return &CodeScanningAlert{} })
+	register("dependabot_alert", func() eventer { return &DependabotAlert{} })
+	register("repository_vulnerability_alert", func() eventer { return &RepositoryVulnerabilityAlert{} })
+	register("secret_scanning_alert", func() eventer { return &SecretScanningAlert{} })
+}
+
+type securityAlert struct {
+	kind     string // alert key, like "dependabot||alert"
+	number   int
+	title    string
+	severity string
+	url      string
+	facts    []event.Fact
+}
+
+// alertActions maps webhook actions onto the state changes we have words for.
+var alertActions = map[string]string{
+	"created":            "created",
+	"create":             "created",
+	"reopened":           "reopened",
+	"reopened_by_user":   "reopened",
+	"reintroduced":       "reintroduced",
+	"appeared_in_branch": "appeared_in_branch",
+	"fixed":              "fixed",
+	"resolve":            "fixed",
+	"resolved":           "resolved",
+	"closed_by_user":     "dismissed",
+	"dismissed":          "dismissed",
+	"dismiss":            "dismissed",
+	"auto_dismissed":     "auto_dismissed",
+	"auto_reopened":      "reopened",
+	"revoked":            "revoked",
+}
+
+func securityEvent(p *message.Printer, c Common, alert securityAlert) *event.Detail {
+	action, ok := alertActions[c.Action]
+	if !ok {
+		return nil
+	}
+
+	color := severityColor(alert.severity)
+	switch action {
+	case "fixed", "resolved", "revoked":
+		color = themeSuccess
+	case "dismissed", "auto_dismissed":
+		color = themeInactive
+	}
+	action += "||alert"
+
+	var facts []event.Fact
+	if alert.severity != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(alertSeverity), Value: p.Sprintf("%#+s", md(alert.severity))})
+	}
+	facts = append(facts, alert.facts...)
+
+	var view []event.Action
+	if alert.url != "" {
+		view = []event.Action{{Name: p.Sprint(viewAlert), URL: alert.url}}
+	}
+
+	return fillEvent(p, c, event.Detail{
+		ThemeColor: color,
+		Summary:    p.Sprintf(message.Key(msgAlertSummary, "%m #%d %m"), alert.kind, alert.number, action),
+		Text:       p.Sprintf(msgAlertText, alert.kind, alert.number, action, md(alert.title)),
+		Fact:       facts,
+		Action:     view,
+	})
+}
+
+func severityColor(severity string) string {
+	switch severity {
+	case "critical", "high", "error":
+		return themeFailure
+	case "medium", "moderate", "warning":
+		return themePending
+	}
+	return ""
+}
+
+type CodeScanningAlert struct {
+	Common
+	Alert struct {
+		Number int
+		URL    string `json:"html_url"`
+		Rule   struct {
+			ID                    string
+			Severity              string
+			SecuritySeverityLevel string `json:"security_severity_level"`
+			Description           string
+		}
+		Tool struct {
+			Name string
+		}
+		MostRecentInstance struct {
+			Location struct {
+				Path      string
+				StartLine int `json:"start_line"`
+				EndLine   int `json:"end_line"`
+			}
+		} `json:"most_recent_instance"`
+		DismissedReason string `json:"dismissed_reason"`
+	}
+}
+
+func (ev CodeScanningAlert) Event(p *message.Printer) *event.Detail {
+	alert := ev.Alert
+	severity := alert.Rule.SecuritySeverityLevel
+	if severity == "" {
+		severity = alert.Rule.Severity
+	}
+
+	facts := []event.Fact{{Name: p.Sprint(alertRule), Value: p.Sprintf("%#s", md(alert.Rule.ID))}}
+	if alert.Tool.Name != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(alertTool), Value: p.Sprintf("%#s", md(alert.Tool.Name))})
+	}
+	if loc := alert.MostRecentInstance.Location; loc.Path != "" {
+		location := loc.Path
+		switch {
+		case loc.StartLine != 0 && loc.EndLine > loc.StartLine:
+			location = fmt.Sprintf("%s:%d-%d", location, loc.StartLine, loc.EndLine)
+		case loc.StartLine != 0:
+			location = fmt.Sprintf("%s:%d", location, loc.StartLine)
+		}
+		facts = append(facts, event.Fact{Name: p.Sprint(alertLocation), Value: p.Sprintf("%#s", md(location))})
+	}
+	if alert.DismissedReason != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(alertResolution), Value: p.Sprintf("%#s", md(alert.DismissedReason))})
+	}
+
+	return securityEvent(p, ev.Common, securityAlert{
+		kind:     alertCodeScanning,
+		number:   alert.Number,
+		title:    alert.Rule.Description,
+		severity: severity,
+		url:      alert.URL,
+		facts:    facts,
+	})
+}
+
+type SecretScanningAlert struct {
+	Common
+	// The alert never includes the secret itself in a webhook, and we
+	// deliberately don't decode it should that ever change.
+	Alert struct {
+		Number                int
+		URL                   string `json:"html_url"`
+		SecretType            string `json:"secret_type"`
+		SecretTypeDisplayName string `json:"secret_type_display_name"`
+		Resolution            string
+	}
+}
+
+func (ev SecretScanningAlert) Event(p *message.Printer) *event.Detail {
+	alert := ev.Alert
+	title := alert.SecretTypeDisplayName
+	if title == "" {
+		title = alert.SecretType
+	}
+
+	facts := []event.Fact{{Name: p.Sprint(alertSecretType), Value: p.Sprintf("%#s", md(alert.SecretType))}}
+	if alert.Resolution != "" {
+		facts = append(facts, event.Fact{Name: p.Sprint(alertResolution), Value: p.Sprintf("%#s", md(alert.Resolution))})
+	}
+
+	return securityEvent(p, ev.Common, securityAlert{
+		kind:   alertSecretScanning,
+		number: alert.Number,
+		title:  title,
+		url:    alert.URL,
+		facts:  facts,
+	})
+}
+
+type DependabotAlert struct {
+	Common
+	Alert struct {
+		Number     int
+		URL        string `json:"html_url"`
+		Dependency struct {
+			Package struct {
+				Ecosystem string
+				Name      string
+			}
+			ManifestPath string `json:"manifest_path"`
+		}
+		SecurityAdvisory struct {
+			GHSAID   string `json:"ghsa_id"`
+			CVEID    string `json:"cve_id"`
+			Summary  string
+			Severity string
+		} `json:"security_advisory"`
+		SecurityVulnerability struct {
+			VulnerableVersionRange string `json:"vulnerable_version_range"`
+			FirstPatchedVersion    struct {
+				Identifier string
+			} `json:"first_patched_version"`
+		} `json:"security_vulnerability"`
+		DismissedReason string `json:"dismissed_reason"`
+	}
+}
+
+func (ev DependabotAlert) Event(p *message.Printer) *event.Detail {
+	alert := ev.Alert
+	advisory := alert.SecurityAdvisory
+	vulnerable := alert.SecurityVulnerability
+	dependency := alert.Dependency
+
+	return securityEvent(p, ev.Common, securityAlert{
+		kind:     alertDependabot,
+		number:   alert.Number,
+		title:    advisory.Summary,
+		severity: advisory.Severity,
+		url:      alert.URL,
+		facts: advisoryFacts(p, advisory.GHSAID, advisory.CVEID,
+			dependency.Package.Ecosystem, dependency.Package.Name, vulnerable.VulnerableVersionRange,
+			vulnerable.FirstPatchedVersion.Identifier, dependency.ManifestPath, alert.DismissedReason),
+	})
+}
+
+// RepositoryVulnerabilityAlert is the older form of DependabotAlert.
+type RepositoryVulnerabilityAlert struct {
+	Common
+	Alert struct {
+		Number              int
+		AffectedPackageName string `json:"affected_package_name"`
+		AffectedRange       string `json:"affected_range"`
+		ExternalIdentifier  string `json:"external_identifier"`
+		GHSAID              string `json:"ghsa_id"`
+		Severity            string
+		FixedIn             string `json:"fixed_in"`
+		ManifestPath        string `json:"manifest_path"`
+		DismissReason       string `json:"dismiss_reason"`
+	}
+}
+
+func (ev RepositoryVulnerabilityAlert) Event(p *message.Printer) *event.Detail {
+	alert := ev.Alert
+	title := alert.ExternalIdentifier
+	if title == "" {
+		title = alert.GHSAID
+	}
+
+	return securityEvent(p, ev.Common, securityAlert{
+		kind:     alertDependabot,
+		number:   alert.Number,
+		title:    title,
+		severity: alert.Severity,
+		url:      ev.Repository.URL + "/security/dependabot",
+		facts: advisoryFacts(p, alert.GHSAID, "", "", alert.AffectedPackageName, alert.AffectedRange,
+			alert.FixedIn, alert.ManifestPath, alert.DismissReason),
+	})
+}
+
+func advisoryFacts(p *message.Printer, ghsa, cve, ecosystem, pkg, vulnerable, patched, manifest, dismissed string) []event.Fact {
+	var facts []event.Fact
+	add := func(name, value string) {
+		if value != "" {
+			facts = append(facts, event.Fact{Name: p.Sprint(name), Value: p.Sprintf("%#s", md(value))})
+		}
+	}
+	add(alertAdvisory, ghsa)
+	add(alertCVE, cve)
+	if ecosystem != "" {
+		pkg = ecosystem + ": " + pkg
+	}
+	add(alertPackage, pkg)
+	add(alertVulnerable, vulnerable)
+	add(alertPatched, patched)
+	add(alertManifest, manifest)
+	add(alertResolution, dismissed)
+	return facts
+}
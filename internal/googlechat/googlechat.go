@@ -0,0 +1,148 @@
+package googlechat
+
+import (
+	"context"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// Request is a Google Chat message holding a single card.
+//
+// Reference: https://developers.google.com/workspace/chat/api/reference/rest/v1/cards
+type Request struct {
+	FallbackText string     `json:"fallbackText,omitempty"` // for notifications
+	CardsV2      []CardWith `json:"cardsV2"`
+}
+
+type CardWith struct {
+	CardID string `json:"cardId"`
+	Card   Card   `json:"card"`
+}
+
+type Card struct {
+	Header   *Header   `json:"header,omitempty"`
+	Sections []Section `json:"sections"`
+}
+
+type Header struct {
+	Title        string `json:"title"`
+	Subtitle     string `json:"subtitle,omitempty"`
+	ImageURL     string `json:"imageUrl,omitempty"`
+	ImageType    string `json:"imageType,omitempty"`
+	ImageAltText string `json:"imageAltText,omitempty"`
+}
+
+type Section struct {
+	Widgets []Widget `json:"widgets"`
+}
+
+// Widget holds exactly one of its kinds of widget.
+type Widget struct {
+	TextParagraph *TextParagraph `json:"textParagraph,omitempty"`
+	DecoratedText *DecoratedText `json:"decoratedText,omitempty"`
+	ButtonList    *ButtonList    `json:"buttonList,omitempty"`
+}
+
+type TextParagraph struct {
+	Text string `json:"text"`
+}
+
+type DecoratedText struct {
+	TopLabel string `json:"topLabel,omitempty"`
+	Text     string `json:"text"`
+	WrapText bool   `json:"wrapText,omitempty"`
+}
+
+type ButtonList struct {
+	Buttons []Button `json:"buttons"`
+}
+
+type Button struct {
+	Text    string  `json:"text"`
+	OnClick OnClick `json:"onClick"`
+}
+
+type OnClick struct {
+	OpenLink OpenLink `json:"openLink"`
+}
+
+type OpenLink struct {
+	URL string `json:"url"`
+}
+
+// chatHTML writes the subset of HTML that card text accepts, which has no
+// tags for code, so code is colored instead.
+//
+// Reference: https://developers.google.com/workspace/chat/format-messages#card-formatting
+var chatHTML = func() markdown.Dialect {
+	d := markdown.HTML
+	d.Code = [2]string{`<font color="#188038">`, "</font>"}
+	d.Fence = d.Code
+	return d
+}()
+
+func (r Request) Submit(ctx context.Context, url string) error {
+	return notifier.PostJSON(ctx, url, r)
+}
+
+func BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return Build(d)
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	var card Card
+
+	if d.Repository != "" || d.Username != "" {
+		header := Header{Title: d.Repository, Subtitle: d.Username}
+		if header.Title == "" {
+			header.Title, header.Subtitle = d.Username, ""
+		}
+		if d.Avatar != "" {
+			header.ImageURL, header.ImageType, header.ImageAltText = d.Avatar, "CIRCLE", d.Username
+		}
+		card.Header = &header
+	}
+
+	var text []Widget
+	if d.Title != "" {
+		text = append(text, Widget{TextParagraph: &TextParagraph{"<b>" + chatHTML.Convert(d.Title) + "</b>"}})
+	}
+	for _, t := range []string{d.Text, d.Body} {
+		if t != "" {
+			text = append(text, Widget{TextParagraph: &TextParagraph{chatHTML.Convert(t)}})
+		}
+	}
+	if len(text) > 0 {
+		card.Sections = append(card.Sections, Section{text})
+	}
+
+	var facts []Widget
+	for _, f := range d.Fact {
+		facts = append(facts, Widget{DecoratedText: &DecoratedText{
+			TopLabel: markdown.Plain.Convert(f.Name),
+			Text:     chatHTML.Convert(f.Value),
+			WrapText: true,
+		}})
+	}
+	if len(facts) > 0 {
+		card.Sections = append(card.Sections, Section{facts})
+	}
+
+	var buttons []Button
+	for _, a := range d.Action {
+		buttons = append(buttons, Button{a.Name, OnClick{OpenLink{a.URL}}})
+	}
+	if len(buttons) > 0 {
+		card.Sections = append(card.Sections, Section{[]Widget{{ButtonList: &ButtonList{buttons}}}})
+	}
+
+	return &Request{
+		FallbackText: markdown.Plain.Convert(d.Summary),
+		CardsV2:      []CardWith{{CardID: "notify", Card: card}},
+	}
+}
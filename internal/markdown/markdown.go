@@ -0,0 +1,325 @@
+// Package markdown converts GitHub markdown, like that written by the md
+// formatter and found in issue and comment bodies, to the formatting of other
+// services.
+package markdown
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	heading = regexp.MustCompile(`^ {0,3}#{1,6}\s+(.*?)\s*#*$`)
+	bullet  = regexp.MustCompile(`^(\s*)[-*+]\s+`)
+)
+
+// Dialect describes how a service writes the formatting Convert understands.
+// Each pair of strings opens and closes a span.
+type Dialect struct {
+	Escape     func(string) string // makes plain text safe; nil leaves it as is
+	EscapeCode func(string) string // makes code safe; nil uses Escape
+	Strong     [2]string
+	Em         [2]string
+	Strike     [2]string
+	Code       [2]string
+	Heading    [2]string
+	Fence      [2]string // replaces the lines of a code fence, without breaks; empty keeps them
+	Bullet     string
+	Quote      string // written once per level of quoting
+	Newline    string
+	Link       func(text, url string) string // text is plain and already escaped
+}
+
+// HTML writes the tags most HTML renderers accept, separating lines with
+// breaks rather than building paragraphs.
+var HTML = Dialect{
+	Escape:  html.EscapeString,
+	Strong:  [2]string{"<b>", "</b>"},
+	Em:      [2]string{"<i>", "</i>"},
+	Strike:  [2]string{"<s>", "</s>"},
+	Code:    [2]string{"<code>", "</code>"},
+	Heading: [2]string{"<b>", "</b>"},
+	Fence:   [2]string{"<pre>", "</pre>"},
+	Bullet:  "• ",
+	Quote:   "&gt;",
+	Newline: "<br>",
+	Link: func(text, url string) string {
+		if !linkable(url) {
+			return text
+		}
+		return `<a href="` + html.EscapeString(url) + `">` + text + "</a>"
+	},
+}
+
+// linkable reports whether rawurl is safe to put in an href, leaving out
+// schemes like javascript: that run or embed something when clicked.
+func linkable(rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// Plain drops formatting for places that show text as is, writing links as
+// their text followed by the URL.
+var Plain = Dialect{
+	Bullet:  "• ",
+	Quote:   ">",
+	Newline: "\n",
+	Link: func(text, url string) string {
+		if text == url {
+			return url
+		}
+		return text + " (" + url + ")"
+	},
+}
+
+func (d Dialect) escape(s string) string {
+	if d.Escape == nil {
+		return s
+	}
+	return d.Escape(s)
+}
+
+func (d Dialect) escapeCode(s string) string {
+	if d.EscapeCode == nil {
+		return d.escape(s)
+	}
+	return d.EscapeCode(s)
+}
+
+// Convert rewrites md in d's formatting.
+//
+// Backslash escapes are resolved, so escaped characters are written bare
+// unless d.Escape escapes them again.
+func (d Dialect) Convert(md string) string {
+	md = strings.ReplaceAll(md, "\r\n", "\n")
+	lines := strings.Split(md, "\n")
+	var out strings.Builder
+	fenced, opened := false, false
+	for i, line := range lines {
+		isFence := strings.HasPrefix(strings.TrimSpace(line), "```")
+		// Replaced fences wrap their lines without breaking before or after them.
+		if i > 0 && !(d.Fence[0] != "" && (opened || fenced && isFence)) {
+			out.WriteString(d.Newline)
+		}
+		opened = false
+		if isFence {
+			switch {
+			case d.Fence[0] == "":
+				out.WriteString(d.escape(line))
+			case fenced:
+				out.WriteString(d.Fence[1])
+			default:
+				out.WriteString(d.Fence[0])
+				opened = true
+			}
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			out.WriteString(d.escapeCode(line))
+			continue
+		}
+
+		if m := heading.FindStringSubmatch(line); m != nil {
+			// Headings written as strong text can't nest more of it.
+			h := d
+			if h.Heading == h.Strong {
+				h.Strong = [2]string{}
+			}
+			out.WriteString(d.Heading[0] + h.inline(m[1]) + d.Heading[1])
+			continue
+		}
+		if loc := bullet.FindStringSubmatchIndex(line); loc != nil {
+			out.WriteString(line[loc[2]:loc[3]] + d.Bullet)
+			line = line[loc[1]:]
+		}
+		quote := ""
+		for strings.HasPrefix(line, ">") {
+			quote += d.Quote
+			line = line[1:]
+		}
+		out.WriteString(quote + d.inline(line))
+	}
+	// Close a fence left open, as cutting text short can.
+	if fenced {
+		if d.Fence[0] != "" {
+			out.WriteString(d.Fence[1])
+		} else {
+			out.WriteString(d.Newline + d.escape("```"))
+		}
+	}
+	return out.String()
+}
+
+// inline converts the spans within a line: emphasis, code, links and escapes.
+func (d Dialect) inline(s string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		switch {
+		case rest[0] == '\\' && len(rest) > 1 && isPunct(rest[1]):
+			out.WriteString(d.escape(rest[1:2]))
+			i += 2
+
+		case rest[0] == '`':
+			ticks := len(rest) - len(strings.TrimLeft(rest, "`"))
+			end := strings.Index(rest[ticks:], rest[:ticks])
+			if end < 0 {
+				out.WriteString(d.escape(rest[:ticks]))
+				i += ticks
+				break
+			}
+			code := rest[ticks : ticks+end]
+			out.WriteString(d.Code[0] + d.escapeCode(strings.TrimSpace(code)) + d.Code[1])
+			i += 2*ticks + end
+
+		case strings.HasPrefix(rest, "**") || strings.HasPrefix(rest, "__"):
+			if end := closing(rest[2:], rest[:2]); end > 0 {
+				out.WriteString(d.Strong[0] + d.inline(rest[2:2+end]) + d.Strong[1])
+				i += 4 + end
+				break
+			}
+			out.WriteString(d.escape(rest[:2]))
+			i += 2
+
+		case strings.HasPrefix(rest, "~~"):
+			if end := closing(rest[2:], "~~"); end > 0 {
+				out.WriteString(d.Strike[0] + d.inline(rest[2:2+end]) + d.Strike[1])
+				i += 4 + end
+				break
+			}
+			out.WriteString(d.escape("~~"))
+			i += 2
+
+		case rest[0] == '*' || rest[0] == '_' && (i == 0 || !isWord(s[i-1])):
+			if end := closing(rest[1:], rest[:1]); end > 0 {
+				out.WriteString(d.Em[0] + d.inline(rest[1:1+end]) + d.Em[1])
+				i += 2 + end
+				break
+			}
+			out.WriteString(d.escape(rest[:1]))
+			i++
+
+		case rest[0] == '[' || strings.HasPrefix(rest, "!["):
+			start := strings.IndexByte(rest, '[')
+			text, url, n := link(rest[start:])
+			if n == 0 {
+				out.WriteString(d.escape(rest[:start+1]))
+				i += start + 1
+				break
+			}
+			out.WriteString(d.Link(d.plain(text), url))
+			i += start + n
+
+		default:
+			out.WriteString(d.escape(rest[:1]))
+			i++
+		}
+	}
+	return out.String()
+}
+
+// closing finds the delimiter that ends an emphasis span in s, which must
+// neither start nor end with a space.
+func closing(s, delim string) int {
+	if s == "" || s[0] == ' ' {
+		return -1
+	}
+	for i := 1; i+len(delim) <= len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case strings.HasPrefix(s[i:], delim) && s[i-1] != ' ':
+			// Underscores inside a word, like snake_case, are never emphasis.
+			if delim[0] == '_' && i+len(delim) < len(s) && isWord(s[i+len(delim)]) {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// link parses [text](url) at the start of s, returning the bytes it spans.
+func link(s string) (text, url string, n int) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				if !strings.HasPrefix(s[i+1:], "(") {
+					return "", "", 0
+				}
+				end := strings.IndexByte(s[i+2:], ')')
+				if end < 0 {
+					return "", "", 0
+				}
+				return s[1:i], s[i+2 : i+2+end], i + 3 + end
+			}
+		}
+	}
+	return "", "", 0
+}
+
+// plain converts link text, dropping its formatting.
+func (d Dialect) plain(s string) string {
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			i++
+			out.WriteString(d.escape(s[i : i+1]))
+		case s[i] == '*' || s[i] == '_' || s[i] == '`':
+		default:
+			out.WriteString(d.escape(s[i : i+1]))
+		}
+	}
+	return out.String()
+}
+
+func isWord(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= 0x80
+}
+
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+// Join separates the non-empty parts with blank lines, making paragraphs of
+// them.
+func Join(parts []string) string {
+	var nonempty []string
+	for _, p := range parts {
+		if p != "" {
+			nonempty = append(nonempty, p)
+		}
+	}
+	return strings.Join(nonempty, "\n\n")
+}
+
+// Truncate shortens s to at most max characters, marking the cut with an
+// ellipsis.
+func Truncate(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	if max <= 0 {
+		return ""
+	}
+	return string(r[:max-1]) + "…"
+}
@@ -0,0 +1,66 @@
+package markdown
+
+import "testing"
+
+func TestHTML(t *testing.T) {
+	for _, tt := range []struct{ md, want string }{
+		{`**username** pushed to **dev**`, `<b>username</b> pushed to <b>dev</b>`},
+		{`RFC: Support \*multiple\* destinations & <more>`, `RFC: Support *multiple* destinations &amp; &lt;more&gt;`},
+		{`see [**#57**](https://github.com/o/r/pull/57?a=1&b=2) now`, `see <a href="https://github.com/o/r/pull/57?a=1&amp;b=2">#57</a> now`},
+		{`*emphasis* and _also_ in snake_case_name`, `<i>emphasis</i> and <i>also</i> in snake_case_name`},
+		{"~~gone~~ and `a <b>`", "<s>gone</s> and <code>a &lt;b&gt;</code>"},
+		{"## Summary\r\n- one\r\n> quoted", "<b>Summary</b><br>• one<br>&gt; quoted"},
+		{"before\n```go\nif a < b {\n```\nafter", "before<br><pre>if a &lt; b {</pre><br>after"},
+		{`2 * 3 * 4`, `2 * 3 * 4`},
+		{"```\nunclosed < code", "<pre>unclosed &lt; code</pre>"},
+		{`[mail](mailto:someone@example.net) [click](javascript:void) [data](DATA:text/html,x)`, `<a href="mailto:someone@example.net">mail</a> click data`},
+	} {
+		if got := HTML.Convert(tt.md); got != tt.want {
+			t.Errorf("HTML.Convert(%q)\n got %q\nwant %q", tt.md, got, tt.want)
+		}
+	}
+}
+
+func TestPlain(t *testing.T) {
+	for _, tt := range []struct{ md, want string }{
+		{`**username** pushed to **release/1\.2**`, `username pushed to release/1.2`},
+		{"see [#57](https://github.com/o/r/pull/57) and `code`", "see #57 (https://github.com/o/r/pull/57) and code"},
+		{`[https://example.net](https://example.net) & <b>`, `https://example.net & <b>`},
+		{"```\nunclosed", "```\nunclosed\n```"},
+	} {
+		if got := Plain.Convert(tt.md); got != tt.want {
+			t.Errorf("Plain.Convert(%q)\n got %q\nwant %q", tt.md, got, tt.want)
+		}
+	}
+}
+
+func TestJoin(t *testing.T) {
+	for _, tt := range []struct {
+		parts []string
+		want  string
+	}{
+		{nil, ""},
+		{[]string{"", "text", "", "body"}, "text\n\nbody"},
+	} {
+		if got := Join(tt.parts); got != tt.want {
+			t.Errorf("Join(%q) = %q, want %q", tt.parts, got, tt.want)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		max  int
+		want string
+	}{
+		{"short", 5, "short"},
+		{"longer", 5, "long…"},
+		{"ünïcödé", 4, "ünï…"},
+		{"gone", 0, ""},
+	} {
+		if got := Truncate(tt.s, tt.max); got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+const tokenInput = "access-token"
+
+// Request sends a message to a room through the client-server API.
+//
+// Reference: https://spec.matrix.org/v1.8/client-server-api/#put_matrixclientv3roomsroomidsendeventtypetxnid
+type Request struct {
+	Room    string
+	Token   string
+	TxnID   string
+	Message Message
+}
+
+// Message is the content of an m.room.message event, with HTML formatting.
+type Message struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+// matrixHTML keeps to the tags the spec suggests clients support, which
+// strike through with del.
+//
+// Reference: https://spec.matrix.org/v1.8/client-server-api/#mroommessage-msgtypes
+var matrixHTML = func() markdown.Dialect {
+	d := markdown.HTML
+	d.Strike = [2]string{"<del>", "</del>"}
+	return d
+}()
+
+// attempts is how many times Submit tries to send a message; the transaction
+// ID keeps a retry of a message that did arrive from showing twice.
+const attempts = 2
+
+// retryDelay is how long Submit waits before trying again.
+var retryDelay = 2 * time.Second
+
+// Preparer returns an EventPreparer that sends to room, authenticating with
+// the access token in env.
+func Preparer(env notifier.Environment, room string) notifier.EventPreparer {
+	return func(ctx context.Context, d *event.Detail) event.Submitter {
+		req := Build(d)
+		if req == nil {
+			return nil
+		}
+		req.Room, req.Token = room, env.Secret(tokenInput)
+		// Sending the same message again from the same step of the same
+		// run, as a retry does, reuses the transaction ID.
+		req.TxnID = txnID(room, os.Getenv("GITHUB_RUN_ID"), os.Getenv("GITHUB_RUN_ATTEMPT"), os.Getenv("GITHUB_ACTION"), req.Message.Body)
+		return req
+	}
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	var text, formatted []string
+	if d.Repository != "" {
+		text = append(text, d.Repository)
+		formatted = append(formatted, "<b>"+html.EscapeString(d.Repository)+"</b>")
+	}
+	for _, t := range []string{d.Title, d.Text, d.Body} {
+		if t != "" {
+			text = append(text, markdown.Plain.Convert(t))
+			formatted = append(formatted, matrixHTML.Convert(t))
+		}
+	}
+
+	var facts, formattedFacts []string
+	for _, f := range d.Fact {
+		facts = append(facts, markdown.Plain.Convert(f.Name)+": "+markdown.Plain.Convert(f.Value))
+		formattedFacts = append(formattedFacts, "<b>"+matrixHTML.Convert(f.Name)+"</b>: "+matrixHTML.Convert(f.Value))
+	}
+	if len(facts) > 0 {
+		text = append(text, strings.Join(facts, "\n"))
+		formatted = append(formatted, strings.Join(formattedFacts, "<br>"))
+	}
+
+	var actions, links []string
+	for _, a := range d.Action {
+		actions = append(actions, a.Name+": "+a.URL)
+		links = append(links, matrixHTML.Link(html.EscapeString(a.Name), a.URL))
+	}
+	if len(actions) > 0 {
+		text = append(text, strings.Join(actions, "\n"))
+		formatted = append(formatted, strings.Join(links, " · "))
+	}
+
+	return &Request{Message: Message{
+		MsgType:       "m.notice",
+		Body:          strings.Join(text, "\n\n"),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: strings.Join(formatted, "<br><br>"),
+	}}
+}
+
+// txnID derives a transaction ID from what identifies a message.
+func txnID(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return "notify-" + hex.EncodeToString(sum[:16])
+}
+
+// Submit sends the message through the homeserver at the base URL. A room in
+// the URL's fragment, as in https://matrix.example.org/#!abc:example.org,
+// takes the place of the request's.
+func (r Request) Submit(ctx context.Context, homeserver string) error {
+	if r.Token == "" {
+		return fmt.Errorf("missing input %q", tokenInput)
+	}
+	u, err := url.Parse(homeserver)
+	if err != nil {
+		return fmt.Errorf("parsing homeserver URL: %w", err)
+	}
+	if room := u.Fragment; room != "" && room != r.Room {
+		r.Room = room
+		// Transaction IDs are per access token, not per room.
+		if r.TxnID != "" {
+			r.TxnID = txnID(r.TxnID, room)
+		}
+	}
+	u.Fragment = ""
+	if r.Room == "" {
+		return errors.New("missing room")
+	}
+	if r.TxnID == "" {
+		r.TxnID = txnID(r.Room, r.Message.Body)
+	}
+	body, err := json.Marshal(r.Message)
+	if err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+
+	endpoint := strings.TrimSuffix(u.String(), "/") + "/_matrix/client/v3/rooms/" + url.PathEscape(r.Room) +
+		"/send/m.room.message/" + url.PathEscape(r.TxnID)
+	header := http.Header{
+		"Authorization": {"Bearer " + r.Token},
+		"Content-Type":  {"application/json"},
+	}
+	for i := 1; ; i++ {
+		err = notifier.Send(ctx, nil, http.MethodPut, endpoint, header, bytes.NewReader(body))
+		if err == nil || i == attempts || !retryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(retryDelay):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// retryable reports whether err may pass on its own: the homeserver could
+// not be reached, was overloaded, or is rate limiting.
+func retryable(err error) bool {
+	var status *notifier.StatusError
+	if errors.As(err, &status) {
+		return status.StatusCode == http.StatusTooManyRequests || status.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
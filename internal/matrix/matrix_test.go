@@ -0,0 +1,171 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+type testEnv map[string]string
+
+func (testEnv) Dump(string, string)           {}
+func (testEnv) Debugf(string, ...interface{}) {}
+func (testEnv) Warnf(string, ...interface{})  {}
+func (testEnv) Fatalf(string, ...interface{}) {}
+func (e testEnv) Secret(input string) string  { return e[input] }
+
+var detail = &event.Detail{
+	Summary:    "username pushed dev",
+	Repository: "orgname/reponame",
+	Text:       "**username** pushed 1 commit to **dev**",
+	Fact:       []event.Fact{{Name: "090e4f202", Value: `~~Adjust~~ table\_row\_count <now>`}},
+	Action:     []event.Action{{Name: "View", URL: "https://github.com/orgname/reponame?a=1&b=2"}},
+}
+
+// homeserver is a stub that fails the first fail requests with status, or a
+// 502, and records the transaction IDs and messages it is sent.
+type homeserver struct {
+	t      *testing.T
+	fail   int
+	status int
+	txns   []string
+	sent   []Message
+}
+
+func init() {
+	retryDelay = 0
+}
+
+func (h *homeserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const prefix = "/_matrix/client/v3/rooms/!abc:example.org/send/m.room.message/"
+	if r.Method != http.MethodPut || !strings.HasPrefix(r.URL.Path, prefix) {
+		h.t.Errorf("got %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+		return
+	}
+	if auth := r.Header.Get("Authorization"); auth != "Bearer syt_token" {
+		h.t.Errorf("got Authorization %q", auth)
+	}
+	var msg Message
+	if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+		h.t.Error(err)
+	}
+	h.txns = append(h.txns, strings.TrimPrefix(r.URL.Path, prefix))
+	if h.fail > 0 {
+		h.fail--
+		status := h.status
+		if status == 0 {
+			status = http.StatusBadGateway
+		}
+		http.Error(w, `{"errcode":"M_UNKNOWN"}`, status)
+		return
+	}
+	h.sent = append(h.sent, msg)
+	w.Write([]byte(`{"event_id":"$event"}`))
+}
+
+func TestSubmit(t *testing.T) {
+	hs := &homeserver{t: t, fail: 1}
+	srv := httptest.NewServer(hs)
+	defer srv.Close()
+
+	env := testEnv{tokenInput: "syt_token"}
+	req := Preparer(env, "!abc:example.org")(context.Background(), detail)
+	if err := req.Submit(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hs.txns) != 2 || hs.txns[0] != hs.txns[1] || hs.txns[0] == "" {
+		t.Errorf("want a retry with the same transaction ID, got %q", hs.txns)
+	}
+	if len(hs.sent) != 1 {
+		t.Fatalf("got %d messages", len(hs.sent))
+	}
+	want := Message{
+		MsgType:       "m.notice",
+		Body:          "orgname/reponame\n\nusername pushed 1 commit to dev\n\n090e4f202: Adjust table_row_count <now>\n\nView: https://github.com/orgname/reponame?a=1&b=2",
+		Format:        "org.matrix.custom.html",
+		FormattedBody: `<b>orgname/reponame</b><br><br><b>username</b> pushed 1 commit to <b>dev</b><br><br><b>090e4f202</b>: <del>Adjust</del> table_row_count &lt;now&gt;<br><br><a href="https://github.com/orgname/reponame?a=1&amp;b=2">View</a>`,
+	}
+	if got := hs.sent[0]; got != want {
+		t.Errorf("got message\n%#v\nwant\n%#v", got, want)
+	}
+}
+
+func TestSubmitErrors(t *testing.T) {
+	hs := &homeserver{t: t, fail: attempts}
+	srv := httptest.NewServer(hs)
+	defer srv.Close()
+
+	if err := Preparer(testEnv{}, "!abc:example.org")(context.Background(), detail).Submit(context.Background(), srv.URL); err == nil {
+		t.Error("want error for missing token")
+	}
+	env := testEnv{tokenInput: "syt_token"}
+	if err := Preparer(env, "!abc:example.org")(context.Background(), detail).Submit(context.Background(), srv.URL); err == nil {
+		t.Error("want error after failed attempts")
+	}
+	if len(hs.txns) != attempts {
+		t.Errorf("got %d attempts, want %d", len(hs.txns), attempts)
+	}
+}
+
+func TestSubmitRetry(t *testing.T) {
+	env := testEnv{tokenInput: "syt_token"}
+	for _, tt := range []struct {
+		status int
+		tries  int
+	}{
+		{http.StatusTooManyRequests, 2},
+		{http.StatusServiceUnavailable, 2},
+		{http.StatusForbidden, 1},
+		{http.StatusBadRequest, 1},
+	} {
+		hs := &homeserver{t: t, fail: 1, status: tt.status}
+		srv := httptest.NewServer(hs)
+		err := Preparer(env, "!abc:example.org")(context.Background(), detail).Submit(context.Background(), srv.URL)
+		srv.Close()
+		if len(hs.txns) != tt.tries {
+			t.Errorf("%d: got %d attempts, want %d", tt.status, len(hs.txns), tt.tries)
+		}
+		if wantErr := tt.tries == 1; (err != nil) != wantErr {
+			t.Errorf("%d: got error %v", tt.status, err)
+		}
+	}
+
+	// Failing to reach the homeserver at all is worth retrying too.
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	if err := Preparer(env, "!abc:example.org")(context.Background(), detail).Submit(context.Background(), srv.URL); !retryable(err) {
+		t.Errorf("got %v, want a network error", err)
+	}
+}
+
+func TestSubmitRoomInURL(t *testing.T) {
+	hs := &homeserver{t: t}
+	srv := httptest.NewServer(hs)
+	defer srv.Close()
+
+	env := testEnv{tokenInput: "syt_token"}
+	prepare := Preparer(env, "")
+	for _, hook := range []string{srv.URL + "/#!abc:example.org", srv.URL + "#!abc:example.org"} {
+		if err := prepare(context.Background(), detail).Submit(context.Background(), hook); err != nil {
+			t.Errorf("Submit(%q): %v", hook, err)
+		}
+	}
+	if len(hs.sent) != 2 {
+		t.Errorf("got %d messages, want 2", len(hs.sent))
+	}
+
+	other := Preparer(env, "!other:example.org")(context.Background(), detail).(*Request)
+	if err := other.Submit(context.Background(), srv.URL+"/#!abc:example.org"); err != nil {
+		t.Fatal(err)
+	}
+	if hs.txns[len(hs.txns)-1] == other.TxnID {
+		t.Error("want the room from the URL to get its own transaction ID")
+	}
+}
@@ -0,0 +1,78 @@
+package mattermost
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// Request is an incoming webhook post with a single message attachment.
+// Mattermost renders markdown much like GitHub does, so text is passed
+// through; only titles, which it shows as is, are made plain.
+//
+// Reference: https://developers.mattermost.com/integrate/reference/message-attachments/
+type Request struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+type Attachment struct {
+	Fallback   string  `json:"fallback,omitempty"`
+	Color      string  `json:"color,omitempty"`
+	AuthorName string  `json:"author_name,omitempty"`
+	AuthorIcon string  `json:"author_icon,omitempty"`
+	Title      string  `json:"title,omitempty"`
+	TitleLink  string  `json:"title_link,omitempty"`
+	Text       string  `json:"text,omitempty"`
+	Fields     []Field `json:"fields,omitempty"`
+}
+
+type Field struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+func (r Request) Submit(ctx context.Context, url string) error {
+	return notifier.PostJSON(ctx, url, r)
+}
+
+func BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return Build(d)
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	att := Attachment{
+		Fallback:   markdown.Plain.Convert(d.Summary),
+		Color:      d.ThemeColor,
+		AuthorName: d.Username,
+		AuthorIcon: d.Avatar,
+		Title:      markdown.Plain.Convert(d.Title),
+	}
+	if att.Title == "" {
+		att.Title = d.Repository
+	}
+
+	text := []string{d.Text, d.Body}
+	var links []string
+	for i, a := range d.Action {
+		if i == 0 {
+			att.TitleLink = a.URL
+		} else if a.Name != "" {
+			links = append(links, "["+a.Name+"]("+a.URL+")")
+		}
+	}
+	text = append(text, strings.Join(links, " · "))
+	att.Text = markdown.Join(text)
+
+	for _, f := range d.Fact {
+		att.Fields = append(att.Fields, Field{Title: markdown.Plain.Convert(f.Name), Value: f.Value, Short: true})
+	}
+
+	return &Request{Attachments: []Attachment{att}}
+}
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/MichaelUrman/notify/internal/event"
@@ -21,6 +24,7 @@ type EventPreparer func(context.Context, *event.Detail) event.Submitter
 type Environment interface {
 	Dump(string, string)
 	Debugf(string, ...interface{})
+	Warnf(string, ...interface{})
 	Fatalf(string, ...interface{})
 	Secret(string) string
 }
@@ -56,6 +60,105 @@ func Main(env Environment, load EventLoader, prepare EventPreparer) (err error)
 	return req.Submit(ctx, url)
 }
 
+// Destination is one of several places MainAll sends an event.
+type Destination struct {
+	Kind     string // the backend, for messages
+	URL      string
+	Required bool // whether the run fails if this destination does
+	Prepare  EventPreparer
+}
+
+// ParseDestinations reads one destination per line, as the name of a backend
+// followed by its URL, and optionally the word required:
+//
+//	teams https://example.webhook.office.com/webhookb2/...
+//	slack https://hooks.slack.com/services/... required
+//
+// Blank lines and lines starting with # are ignored.
+func ParseDestinations(text string, backends map[string]EventPreparer) ([]Destination, error) {
+	var dests []Destination
+	for i, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) < 2 || len(fields) > 3 || len(fields) == 3 && fields[2] != "required" {
+			return nil, fmt.Errorf("destination on line %d is not: backend url [required]", i+1)
+		}
+		prepare, ok := backends[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("unknown backend %q on line %d", fields[0], i+1)
+		}
+		dests = append(dests, Destination{
+			Kind:     fields[0],
+			URL:      fields[1],
+			Required: len(fields) == 3,
+			Prepare:  prepare,
+		})
+	}
+	return dests, nil
+}
+
+// MainAll is like Main, but sends the event to each destination at once. It
+// warns of each destination that fails, and fails itself only if all of them
+// do, or one that is required does.
+func MainAll(env Environment, load EventLoader, dests []Destination) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	defer func() {
+		if err != nil {
+			env.Dump("payload", os.Getenv("GITHUB_EVENT_PATH"))
+			env.Fatalf("handling event: %v", err)
+		}
+	}()
+
+	if len(dests) == 0 {
+		return errors.New("no destinations")
+	}
+	detail, err := load(ctx)
+	if err != nil {
+		return err
+	}
+	if detail == nil {
+		env.Debugf("No message sent")
+		return nil
+	}
+
+	errs := make([]error, len(dests))
+	var wg sync.WaitGroup
+	for i, dest := range dests {
+		wg.Add(1)
+		go func(i int, dest Destination) {
+			defer wg.Done()
+			errs[i] = dest.Prepare(ctx, detail).Submit(ctx, dest.URL)
+		}(i, dest)
+	}
+	wg.Wait()
+
+	failed := 0
+	var required error
+	for i, err := range errs {
+		name := fmt.Sprintf("destination %d (%s)", i+1, dests[i].Kind)
+		if err == nil {
+			env.Debugf("Sent to %s", name)
+			continue
+		}
+		failed++
+		env.Warnf("%s failed: %v", name, err)
+		if dests[i].Required && required == nil {
+			required = fmt.Errorf("required %s failed: %w", name, err)
+		}
+	}
+	if required != nil {
+		return required
+	}
+	if failed == len(dests) {
+		return fmt.Errorf("all %d destinations failed", failed)
+	}
+	return nil
+}
+
 // PostJSON encodes req to JSON, and Posts it.
 func PostJSON(ctx context.Context, url string, data interface{}) error {
 
@@ -70,14 +173,22 @@ func PostJSON(ctx context.Context, url string, data interface{}) error {
 }
 
 func Post(ctx context.Context, cli *http.Client, url string, body io.Reader) error {
+	return Send(ctx, cli, http.MethodPost, url, http.Header{"Content-Type": {"application/json"}}, body)
+}
+
+// Send makes a request with the given method and headers, treating any
+// response other than a 2xx as an error.
+func Send(ctx context.Context, cli *http.Client, method, url string, header http.Header, body io.Reader) error {
 	if cli == nil {
 		cli = http.DefaultClient
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
-	req.Header.Add("Content-Type", "application/json")
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return fmt.Errorf("creating request: %w", err)
 	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
 	resp, err := cli.Do(req)
 	if err != nil {
 		return fmt.Errorf("posting request: %w", err)
@@ -90,8 +201,18 @@ func Post(ctx context.Context, cli *http.Client, url string, body io.Reader) err
 		if err = resp.Body.Close(); err != nil {
 			return fmt.Errorf("closing response: %w", err)
 		}
-		return fmt.Errorf("webhook failed (%v): %s", resp.StatusCode, response)
+		return &StatusError{StatusCode: resp.StatusCode, Response: response}
 	}
 
 	return nil
 }
+
+// StatusError reports a response other than a 2xx.
+type StatusError struct {
+	StatusCode int
+	Response   []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("webhook failed (%v): %s", e.StatusCode, e.Response)
+}
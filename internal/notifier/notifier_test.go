@@ -0,0 +1,121 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+type testEnv struct {
+	mu    sync.Mutex
+	warn  []string
+	fatal string
+}
+
+func (*testEnv) Dump(string, string)           {}
+func (*testEnv) Debugf(string, ...interface{}) {}
+func (*testEnv) Secret(string) string          { return "" }
+
+func (e *testEnv) Warnf(format string, a ...interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.warn = append(e.warn, fmt.Sprintf(format, a...))
+}
+
+func (e *testEnv) Fatalf(format string, a ...interface{}) {
+	e.fatal = fmt.Sprintf(format, a...)
+}
+
+type submitFunc func(context.Context, string) error
+
+func (f submitFunc) Submit(ctx context.Context, url string) error { return f(ctx, url) }
+
+func load(context.Context) (*event.Detail, error) {
+	return &event.Detail{Summary: "username pushed dev"}, nil
+}
+
+// backends succeed for URLs starting with ok, and wait for every other
+// destination to start before returning, to show they are sent at once.
+func backends(started *sync.WaitGroup) map[string]EventPreparer {
+	prepare := func(ctx context.Context, d *event.Detail) event.Submitter {
+		return submitFunc(func(ctx context.Context, url string) error {
+			started.Done()
+			done := make(chan struct{})
+			go func() { started.Wait(); close(done) }()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				return errors.New("destinations were not sent concurrently")
+			}
+			if !strings.HasPrefix(url, "ok") {
+				return errors.New("webhook failed (500)")
+			}
+			return nil
+		})
+	}
+	return map[string]EventPreparer{"teams": prepare, "slack": prepare, "webhook": prepare}
+}
+
+func TestMainAll(t *testing.T) {
+	for _, tt := range []struct {
+		name, dests string
+		warn        int
+		err         string
+	}{
+		{"all sent", "teams ok1\nslack ok2 required\nwebhook ok3", 0, ""},
+		{"some failed", "teams ok1\n\n# comment\nslack bad2\nwebhook bad3", 2, ""},
+		{"all failed", "teams bad1\nslack bad2", 2, "all 2 destinations failed"},
+		{"none", "# nothing\n", 0, "no destinations"},
+		{"required failed", "teams ok1\nslack bad2 required", 1, "required destination 2 (slack) failed: webhook failed (500)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var started sync.WaitGroup
+			dests, err := ParseDestinations(tt.dests, backends(&started))
+			if err != nil {
+				t.Fatal(err)
+			}
+			started.Add(len(dests))
+
+			env := &testEnv{}
+			err = MainAll(env, load, dests)
+			if got := fmt.Sprint(err); tt.err != "" && got != tt.err || tt.err == "" && err != nil {
+				t.Errorf("got error %v, want %q", err, tt.err)
+			}
+			if len(env.warn) != tt.warn {
+				t.Errorf("got warnings %q, want %d", env.warn, tt.warn)
+			}
+			if (env.fatal != "") != (tt.err != "") {
+				t.Errorf("got fatal %q", env.fatal)
+			}
+		})
+	}
+}
+
+func TestParseDestinations(t *testing.T) {
+	var started sync.WaitGroup
+	dests, err := ParseDestinations("  teams https://a  \nslack https://b required\n", backends(&started))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dests) != 2 || dests[0].Kind != "teams" || dests[0].URL != "https://a" || dests[0].Required ||
+		dests[1].Kind != "slack" || dests[1].URL != "https://b" || !dests[1].Required {
+		t.Errorf("got %+v", dests)
+	}
+
+	for _, text := range []string{
+		"teams",
+		"irc https://c",
+		"slack https://b optional",
+		"slack https://b required extra",
+	} {
+		if _, err := ParseDestinations(text, backends(&started)); err == nil {
+			t.Errorf("ParseDestinations(%q): want error", text)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// gotifyMessage is created with an application token.
+//
+// Reference: https://gotify.net/api-docs#/message/createMessage
+type gotifyMessage struct {
+	Title    string                 `json:"title,omitempty"`
+	Message  string                 `json:"message"`
+	Priority int                    `json:"priority"`
+	Extras   map[string]interface{} `json:"extras,omitempty"`
+}
+
+// gotifyPriority ranks job statuses on Gotify's 0 to 10 scale, where the
+// Android app only makes a sound from 4 and pops up a notification from 8.
+var gotifyPriority = map[string]int{
+	"success":   2,
+	"failure":   8,
+	"cancelled": 5,
+	"skipped":   1,
+	"timed_out": 8,
+}
+
+const gotifyDefaultPriority = 5
+
+// gotify creates a message through the application whose token is in u's
+// query. Gotify's clients render markdown, so the text is sent as is.
+func (r Request) gotify(ctx context.Context, u *url.URL, scheme string) error {
+	token := u.Query().Get("token")
+	if token == "" {
+		return errors.New("gotify URL needs an application token")
+	}
+	text := r.lines(func(md string) string { return md }, "  \n", func(name, value string) string { return "**" + name + "**: " + value })
+	var links []string
+	for _, a := range r.Detail.Action {
+		links = append(links, "["+a.Name+"]("+a.URL+")")
+	}
+	if len(links) > 0 {
+		text = append(text, strings.Join(links, " · "))
+	}
+
+	msg := gotifyMessage{
+		Title:    r.title(),
+		Message:  strings.Join(text, "\n\n"),
+		Priority: gotifyDefaultPriority,
+		Extras: map[string]interface{}{
+			"client::display": map[string]string{"contentType": "text/markdown"},
+		},
+	}
+	if p, ok := gotifyPriority[r.Detail.Status]; ok {
+		msg.Priority = p
+	}
+	if len(r.Detail.Action) > 0 {
+		msg.Extras["client::notification"] = map[string]interface{}{
+			"click": map[string]string{"url": r.Detail.Action[0].URL},
+		}
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+	header := http.Header{
+		"Content-Type": {"application/json"},
+		"X-Gotify-Key": {token},
+	}
+	endpoint := (&url.URL{Scheme: scheme, Host: u.Host, Path: strings.TrimSuffix(u.Path, "/") + "/message"}).String()
+	return notifier.Send(ctx, nil, http.MethodPost, endpoint, header, bytes.NewReader(body))
+}
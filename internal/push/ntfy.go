@@ -0,0 +1,90 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// ntfyMessage is published as JSON to the root of the server.
+//
+// Reference: https://docs.ntfy.sh/publish/#publish-as-json
+type ntfyMessage struct {
+	Topic    string       `json:"topic"`
+	Title    string       `json:"title,omitempty"`
+	Message  string       `json:"message"`
+	Priority int          `json:"priority,omitempty"`
+	Tags     []string     `json:"tags,omitempty"`
+	Click    string       `json:"click,omitempty"`
+	Actions  []ntfyAction `json:"actions,omitempty"`
+}
+
+type ntfyAction struct {
+	Action string `json:"action"`
+	Label  string `json:"label"`
+	URL    string `json:"url"`
+}
+
+// ntfyMaxActions is the number of action buttons a notification may have.
+const ntfyMaxActions = 3
+
+// ntfyStatus gives the priority (1 to 5) and emoji tags of a job status, so
+// that failures stand out and successes stay quiet.
+var ntfyStatus = map[string]struct {
+	priority int
+	tags     []string
+}{
+	"success":   {2, []string{"heavy_check_mark"}},
+	"failure":   {4, []string{"x"}},
+	"cancelled": {3, []string{"no_entry_sign"}},
+	"skipped":   {2, []string{"white_circle"}},
+	"timed_out": {4, []string{"hourglass"}},
+}
+
+// ntfy publishes the message to the topic at the end of u's path.
+func (r Request) ntfy(ctx context.Context, u *url.URL, scheme string) error {
+	base, topic := path.Split(strings.TrimSuffix(u.Path, "/"))
+	if topic == "" {
+		return errors.New("ntfy URL needs a topic")
+	}
+	msg := ntfyMessage{
+		Topic:   topic,
+		Title:   r.title(),
+		Message: strings.Join(r.lines(markdown.Plain.Convert, "\n", func(name, value string) string { return name + ": " + value }), "\n\n"),
+	}
+	if s, ok := ntfyStatus[r.Detail.Status]; ok {
+		msg.Priority, msg.Tags = s.priority, s.tags
+	}
+	for i, a := range r.Detail.Action {
+		if i == 0 {
+			msg.Click = a.URL
+		}
+		if len(msg.Actions) < ntfyMaxActions {
+			msg.Actions = append(msg.Actions, ntfyAction{"view", a.Name, a.URL})
+		}
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+	header := http.Header{"Content-Type": {"application/json"}}
+	if token := u.Query().Get("token"); token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	} else if u.User != nil {
+		password, _ := u.User.Password()
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(u.User.Username()+":"+password)))
+	}
+	endpoint := (&url.URL{Scheme: scheme, Host: u.Host, Path: base}).String()
+	return notifier.Send(ctx, nil, http.MethodPost, endpoint, header, bytes.NewReader(body))
+}
@@ -0,0 +1,142 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// telegramAPI is the Bot API server; tests replace it with their own.
+var telegramAPI = "https://api.telegram.org"
+
+// maxTelegramText is the most characters sendMessage accepts.
+const maxTelegramText = 4096
+
+type telegramMessage struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+var (
+	// MarkdownV2 reserves more characters than GitHub markdown, and all
+	// of them must be escaped outside of code and links.
+	telegramEscaper = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`,
+		"~", `\~`, "`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`,
+		"=", `\=`, "|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+	)
+	telegramCodeEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+	telegramURLEscaper  = strings.NewReplacer(`\`, `\\`, ")", `\)`)
+)
+
+// telegramMarkdown writes Telegram's MarkdownV2.
+//
+// Reference: https://core.telegram.org/bots/api#markdownv2-style
+var telegramMarkdown = markdown.Dialect{
+	Escape:     telegramEscaper.Replace,
+	EscapeCode: telegramCodeEscaper.Replace,
+	Strong:     [2]string{"*", "*"},
+	Em:         [2]string{"_", "_"},
+	Strike:     [2]string{"~", "~"},
+	Code:       [2]string{"`", "`"},
+	Heading:    [2]string{"*", "*"},
+	Fence:      [2]string{"```\n", "\n```"},
+	Bullet:     "• ",
+	Quote:      ">",
+	Newline:    "\n",
+	Link: func(text, url string) string {
+		return "[" + text + "](" + telegramURLEscaper.Replace(url) + ")"
+	},
+}
+
+// telegram sends the message with sendMessage, as the bot whose token is the
+// user info of u to the chat in its query.
+func (r Request) telegram(ctx context.Context, u *url.URL) error {
+	token := u.User.Username()
+	if password, ok := u.User.Password(); ok {
+		token += ":" + password
+	}
+	chat := u.Query().Get("chat")
+	if token == "" || chat == "" {
+		return errors.New("telegram URL needs a bot token and chat")
+	}
+
+	// Make room for the body by dropping links and then facts, and as a last
+	// resort by cutting the rest of the text, then cut the body to fit.
+	body := r.Detail.Body
+	r.Detail.Body = ""
+	for r.telegramOver() > 0 && len(r.Detail.Action) > 0 {
+		r.Detail.Action = r.Detail.Action[:len(r.Detail.Action)-1]
+	}
+	for r.telegramOver() > 0 && len(r.Detail.Fact) > 0 {
+		r.Detail.Fact = r.Detail.Fact[:len(r.Detail.Fact)-1]
+	}
+	r.telegramFit(&r.Detail.Title)
+	r.telegramFit(&r.Detail.Text)
+	r.telegramFit(&r.Detail.Repository)
+	r.Detail.Body = body
+	r.telegramFit(&r.Detail.Body)
+
+	err := notifier.PostJSON(ctx, telegramAPI+"/bot"+token+"/sendMessage", telegramMessage{
+		ChatID:                chat,
+		Text:                  r.telegramText(),
+		ParseMode:             "MarkdownV2",
+		DisableWebPagePreview: true,
+	})
+	// The token is in the request path, so keep the URL out of errors.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("posting request: %w", urlErr.Err)
+	}
+	return err
+}
+
+// telegramOver returns how many characters the message is over the limit.
+func (r Request) telegramOver() int {
+	return utf8.RuneCountInString(r.telegramText()) - maxTelegramText
+}
+
+// telegramFit cuts the markdown in *s as little as keeps the message within
+// the limit, searching since escaping makes the cut text grow.
+func (r *Request) telegramFit(s *string) {
+	if r.telegramOver() <= 0 {
+		return
+	}
+	full := *s
+	fits, over := 0, utf8.RuneCountInString(full)
+	for fits+1 < over {
+		mid := (fits + over) / 2
+		if *s = markdown.Truncate(full, mid); r.telegramOver() <= 0 {
+			fits = mid
+		} else {
+			over = mid
+		}
+	}
+	*s = markdown.Truncate(full, fits)
+}
+
+func (r Request) telegramText() string {
+	var text []string
+	if r.Detail.Repository != "" {
+		text = append(text, "*"+telegramMarkdown.Escape(r.Detail.Repository)+"*")
+	}
+	text = append(text, r.lines(telegramMarkdown.Convert, "\n", func(name, value string) string {
+		return "*" + name + "*: " + value
+	})...)
+	var links []string
+	for _, a := range r.Detail.Action {
+		links = append(links, telegramMarkdown.Link(telegramMarkdown.Escape(a.Name), a.URL))
+	}
+	if len(links) > 0 {
+		text = append(text, strings.Join(links, " · "))
+	}
+	return strings.Join(text, "\n\n")
+}
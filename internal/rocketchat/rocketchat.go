@@ -0,0 +1,95 @@
+package rocketchat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// Request is an incoming webhook message with a single attachment.
+//
+// Reference: https://developer.rocket.chat/reference/api/rest-api/endpoints/messaging/chat-endpoints/postmessage#attachments-detail
+type Request struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+type Attachment struct {
+	Color      string  `json:"color,omitempty"`
+	AuthorName string  `json:"author_name,omitempty"`
+	AuthorIcon string  `json:"author_icon,omitempty"`
+	Title      string  `json:"title,omitempty"`
+	TitleLink  string  `json:"title_link,omitempty"`
+	Text       string  `json:"text,omitempty"`
+	Fields     []Field `json:"fields,omitempty"`
+}
+
+type Field struct {
+	Short bool   `json:"short"`
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// rocketMarkdown writes Rocket.Chat's markdown, which like Slack's marks bold
+// with single asterisks but writes links the usual way.
+//
+// Reference: https://docs.rocket.chat/use-rocket.chat/user-guides/rooms/messages#message-formatting
+var rocketMarkdown = markdown.Dialect{
+	Strong:  [2]string{"*", "*"},
+	Em:      [2]string{"_", "_"},
+	Strike:  [2]string{"~", "~"},
+	Code:    [2]string{"`", "`"},
+	Heading: [2]string{"*", "*"},
+	Bullet:  "• ",
+	Quote:   ">",
+	Newline: "\n",
+	Link: func(text, url string) string {
+		return "[" + text + "](" + url + ")"
+	},
+}
+
+func (r Request) Submit(ctx context.Context, url string) error {
+	return notifier.PostJSON(ctx, url, r)
+}
+
+func BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return Build(d)
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	att := Attachment{
+		Color:      d.ThemeColor,
+		AuthorName: d.Username,
+		AuthorIcon: d.Avatar,
+		Title:      markdown.Plain.Convert(d.Title),
+	}
+	if att.Title == "" {
+		att.Title = d.Repository
+	}
+
+	var text []string
+	for _, t := range []string{d.Text, d.Body} {
+		text = append(text, rocketMarkdown.Convert(t))
+	}
+	var links []string
+	for i, a := range d.Action {
+		if i == 0 {
+			att.TitleLink = a.URL
+		} else if a.Name != "" {
+			links = append(links, rocketMarkdown.Link(a.Name, a.URL))
+		}
+	}
+	text = append(text, strings.Join(links, " · "))
+	att.Text = markdown.Join(text)
+
+	for _, f := range d.Fact {
+		att.Fields = append(att.Fields, Field{Short: true, Title: markdown.Plain.Convert(f.Name), Value: rocketMarkdown.Convert(f.Value)})
+	}
+
+	return &Request{Attachments: []Attachment{att}}
+}
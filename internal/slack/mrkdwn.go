@@ -0,0 +1,38 @@
+package slack
+
+import (
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/markdown"
+)
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// escape makes plain text safe for mrkdwn, which only reserves &, < and >.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// mrkdwnDialect writes Slack's mrkdwn. Slack has no backslash escapes, so the
+// rare literal asterisk or underscore may end up formatting text.
+//
+// Reference: https://api.slack.com/reference/surfaces/formatting
+var mrkdwnDialect = markdown.Dialect{
+	Escape:  escape,
+	Strong:  [2]string{"*", "*"},
+	Em:      [2]string{"_", "_"},
+	Strike:  [2]string{"~", "~"},
+	Code:    [2]string{"`", "`"},
+	Heading: [2]string{"*", "*"},
+	Bullet:  "• ",
+	Quote:   ">",
+	Newline: "\n",
+	Link: func(text, url string) string {
+		return "<" + strings.ReplaceAll(url, "|", "%7C") + "|" + strings.ReplaceAll(text, "|", "") + ">"
+	},
+}
+
+// Mrkdwn converts GitHub markdown to Slack's mrkdwn.
+func Mrkdwn(md string) string {
+	return mrkdwnDialect.Convert(md)
+}
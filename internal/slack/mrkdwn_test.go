@@ -0,0 +1,25 @@
+package slack
+
+import "testing"
+
+func TestMrkdwn(t *testing.T) {
+	for _, tt := range []struct{ md, want string }{
+		{`**username** pushed to **dev**`, `*username* pushed to *dev*`},
+		{`RFC: Support \*multiple\* destinations`, `RFC: Support *multiple* destinations`},
+		{`release/1\.2 and notify\_client`, `release/1.2 and notify_client`},
+		{`see [#57](https://github.com/o/r/pull/57) now`, `see <https://github.com/o/r/pull/57|#57> now`},
+		{`[**v1\.2**](https://example.net/a|b)`, `<https://example.net/a%7Cb|v1.2>`},
+		{`*emphasis* and _also_ in snake_case_name`, `_emphasis_ and _also_ in snake_case_name`},
+		{`~~gone~~ & <kept>`, `~gone~ &amp; &lt;kept&gt;`},
+		{"Use `a **b** <c>` here", "Use `a **b** &lt;c&gt;` here"},
+		{"## Summary\r\n- one\r\n  * two", "*Summary*\n• one\n  • two"},
+		{"> quoted *text*", "> quoted _text_"},
+		{"```\nif a < b {\n```", "```\nif a &lt; b {\n```"},
+		{`2 * 3 * 4`, `2 * 3 * 4`},
+		{`**unclosed`, `**unclosed`},
+	} {
+		if got := Mrkdwn(tt.md); got != tt.want {
+			t.Errorf("Mrkdwn(%q)\n got %q\nwant %q", tt.md, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// Block Kit limits; longer text is rejected rather than truncated by Slack.
+//
+// Reference: https://api.slack.com/reference/block-kit/blocks
+const (
+	maxHeader  = 150
+	maxText    = 3000
+	maxField   = 2000
+	maxFields  = 10
+	maxButton  = 75
+	maxButtons = 25
+)
+
+type Request struct {
+	Text   string  `json:"text,omitempty"`
+	Blocks []Block `json:"blocks,omitempty"`
+}
+
+type Block struct {
+	Type     string    `json:"type"`
+	Text     *Text     `json:"text,omitempty"`
+	Fields   []Text    `json:"fields,omitempty"`
+	Elements []Element `json:"elements,omitempty"`
+}
+
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Element is an image or mrkdwn text in a context block, or a button in an
+// actions block. A button's Text is its label.
+type Element struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	AltText  string `json:"alt_text,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+type element Element // Element without its JSON methods
+
+type button struct {
+	Type string `json:"type"`
+	Text Text   `json:"text"`
+	URL  string `json:"url"`
+}
+
+// MarshalJSON writes a button's label as the plain text object Slack wants.
+func (e Element) MarshalJSON() ([]byte, error) {
+	if e.Type == "button" {
+		return json.Marshal(button{e.Type, Text{"plain_text", e.Text}, e.URL})
+	}
+	return json.Marshal(element(e))
+}
+
+func (e *Element) UnmarshalJSON(data []byte) error {
+	var kind struct{ Type string }
+	if err := json.Unmarshal(data, &kind); err != nil {
+		return err
+	}
+	if kind.Type != "button" {
+		return json.Unmarshal(data, (*element)(e))
+	}
+	var btn button
+	if err := json.Unmarshal(data, &btn); err != nil {
+		return err
+	}
+	*e = Element{Type: btn.Type, Text: btn.Text.Text, URL: btn.URL}
+	return nil
+}
+
+func (r Request) Submit(ctx context.Context, url string) error {
+	return notifier.PostJSON(ctx, url, r)
+}
+
+func BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return Build(d)
+}
+
+func Build(d *event.Detail) *Request {
+	if d == nil {
+		return nil
+	}
+	req := Request{Text: d.Summary}
+
+	if d.Repository != "" {
+		req.Blocks = append(req.Blocks, Block{Type: "header", Text: plain(d.Repository, maxHeader)})
+	}
+	if d.Avatar != "" || d.Username != "" {
+		var byline []Element
+		if d.Avatar != "" {
+			alt := d.Username
+			if alt == "" {
+				alt = "avatar"
+			}
+			byline = append(byline, Element{Type: "image", ImageURL: d.Avatar, AltText: alt})
+		}
+		if d.Username != "" {
+			byline = append(byline, Element{Type: "mrkdwn", Text: escape(d.Username)})
+		}
+		req.Blocks = append(req.Blocks, Block{Type: "context", Elements: byline})
+	}
+	for _, text := range []string{d.Title, d.Text, d.Body} {
+		if text != "" {
+			req.Blocks = append(req.Blocks, Block{Type: "section", Text: mrkdwn(Mrkdwn(text), maxText)})
+		}
+	}
+
+	var fields []Text
+	for _, f := range d.Fact {
+		fields = append(fields, *mrkdwn("*"+Mrkdwn(f.Name)+"*\n"+Mrkdwn(f.Value), maxField))
+	}
+	for len(fields) > 0 {
+		n := len(fields)
+		if n > maxFields {
+			n = maxFields
+		}
+		req.Blocks = append(req.Blocks, Block{Type: "section", Fields: fields[:n]})
+		fields = fields[n:]
+	}
+
+	var buttons []Element
+	for _, a := range d.Action {
+		if len(buttons) == maxButtons {
+			break
+		}
+		buttons = append(buttons, Element{Type: "button", Text: markdown.Truncate(a.Name, maxButton), URL: a.URL})
+	}
+	if len(buttons) > 0 {
+		req.Blocks = append(req.Blocks, Block{Type: "actions", Elements: buttons})
+	}
+
+	return &req
+}
+
+func plain(s string, max int) *Text {
+	return &Text{Type: "plain_text", Text: markdown.Truncate(s, max)}
+}
+
+func mrkdwn(s string, max int) *Text {
+	return &Text{Type: "mrkdwn", Text: markdown.Truncate(s, max)}
+}
@@ -0,0 +1,146 @@
+package teams
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// AdaptiveRequest wraps an Adaptive Card the way Workflows (Power Automate)
+// webhooks expect, replacing the MessageCard of retired connector webhooks.
+//
+// Reference: https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using
+type AdaptiveRequest struct {
+	Type        string       `json:"type"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+type Attachment struct {
+	ContentType string       `json:"contentType"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+type AdaptiveCard struct {
+	Schema  string          `json:"$schema"`
+	Type    string          `json:"type"`
+	Version string          `json:"version"`
+	Body    []CardElement   `json:"body"`
+	Actions []OpenURLAction `json:"actions,omitempty"`
+}
+
+// CardElement is one of the few card elements we use: a TextBlock, an Image, a
+// FactSet, or a ColumnSet of Columns holding further elements.
+type CardElement struct {
+	Type     string        `json:"type"`
+	Text     string        `json:"text,omitempty"`
+	Weight   string        `json:"weight,omitempty"`
+	Size     string        `json:"size,omitempty"`
+	IsSubtle bool          `json:"isSubtle,omitempty"`
+	Spacing  string        `json:"spacing,omitempty"`
+	Wrap     bool          `json:"wrap,omitempty"`
+	URL      string        `json:"url,omitempty"`
+	AltText  string        `json:"altText,omitempty"`
+	Style    string        `json:"style,omitempty"`
+	Width    string        `json:"width,omitempty"`
+	Facts    []CardFact    `json:"facts,omitempty"`
+	Columns  []CardElement `json:"columns,omitempty"`
+	Items    []CardElement `json:"items,omitempty"`
+}
+
+type CardFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type OpenURLAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func (r AdaptiveRequest) Submit(ctx context.Context, url string) error {
+	return notifier.PostJSON(ctx, url, r)
+}
+
+func BuildAdaptiveSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	return BuildAdaptive(d)
+}
+
+// BuildAdaptive lays out d like Build does, with the activity section as a
+// column set beside the avatar, followed by the text and facts.
+func BuildAdaptive(d *event.Detail) *AdaptiveRequest {
+	if d == nil {
+		return nil
+	}
+	card := AdaptiveCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+	}
+
+	if d.Title != "" {
+		card.Body = append(card.Body, CardElement{Type: "TextBlock", Text: adaptiveText(d.Title), Size: "Large", Weight: "Bolder", Wrap: true})
+	}
+
+	activity := CardElement{Type: "Column", Width: "stretch"}
+	if d.Repository != "" {
+		activity.Items = append(activity.Items, CardElement{Type: "TextBlock", Text: d.Repository, Weight: "Bolder", Wrap: true})
+	}
+	if d.Username != "" {
+		activity.Items = append(activity.Items, CardElement{Type: "TextBlock", Text: d.Username, IsSubtle: true, Spacing: "None", Wrap: true})
+	}
+	var columns []CardElement
+	if d.Avatar != "" {
+		columns = append(columns, CardElement{Type: "Column", Width: "auto", Items: []CardElement{
+			{Type: "Image", URL: d.Avatar, AltText: d.Username, Style: "Person", Size: "Small"},
+		}})
+	}
+	if len(activity.Items) > 0 {
+		columns = append(columns, activity)
+	}
+	if len(columns) > 0 {
+		card.Body = append(card.Body, CardElement{Type: "ColumnSet", Columns: columns})
+	}
+
+	for _, text := range []string{d.Text, d.Body} {
+		if text != "" {
+			card.Body = append(card.Body, CardElement{Type: "TextBlock", Text: adaptiveText(text), Wrap: true})
+		}
+	}
+
+	if len(d.Fact) > 0 {
+		facts := CardElement{Type: "FactSet"}
+		for _, f := range d.Fact {
+			facts.Facts = append(facts.Facts, CardFact{adaptiveText(f.Name), adaptiveText(f.Value)})
+		}
+		card.Body = append(card.Body, facts)
+	}
+
+	for _, a := range d.Action {
+		card.Actions = append(card.Actions, OpenURLAction{"Action.OpenUrl", a.Name, a.URL})
+	}
+
+	return &AdaptiveRequest{
+		Type: "message",
+		Attachments: []Attachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content:     card,
+		}},
+	}
+}
+
+// adaptiveText removes the backslash escapes that Adaptive Cards' smaller
+// markdown dialect would show literally, keeping those for the emphasis and
+// link characters it does understand.
+func adaptiveText(md string) string {
+	var out strings.Builder
+	for i := 0; i < len(md); i++ {
+		if md[i] == '\\' && i+1 < len(md) && strings.IndexByte("!\"#$%&'+,-./:;<=>?@^`{|}~", md[i+1]) >= 0 {
+			i++
+		}
+		out.WriteByte(md[i])
+	}
+	return out.String()
+}
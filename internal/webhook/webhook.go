@@ -0,0 +1,164 @@
+// Package webhook renders events with a user supplied text/template, for
+// services that have no backend of their own.
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/MichaelUrman/notify/internal/event"
+	"github.com/MichaelUrman/notify/internal/markdown"
+	"github.com/MichaelUrman/notify/internal/notifier"
+)
+
+// funcs are available to templates in addition to text/template's own.
+var funcs = template.FuncMap{
+	// json writes a value as JSON, quoting and escaping strings.
+	"json": func(v interface{}) (string, error) {
+		var b strings.Builder
+		enc := json.NewEncoder(&b)
+		enc.SetEscapeHTML(false)
+		err := enc.Encode(v)
+		return strings.TrimSuffix(b.String(), "\n"), err
+	},
+	// plain drops markdown formatting, like the ** around usernames.
+	"plain": markdown.Plain.Convert,
+	// markdownHTML converts markdown to HTML.
+	"markdownHTML": markdown.HTML.Convert,
+}
+
+// Hook renders an event.Detail into the body of a request.
+type Hook struct {
+	Template    *template.Template
+	Method      string
+	Header      http.Header
+	ContentType string
+}
+
+// New parses the template and headers, one "Name: value" per line, and
+// defaults to POSTing JSON.
+func New(text, method, headers, contentType string) (*Hook, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("missing template")
+	}
+	tmpl, err := template.New("webhook").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template: %w", err)
+	}
+	header, err := parseHeader(headers)
+	if err != nil {
+		return nil, err
+	}
+	if method == "" {
+		method = http.MethodPost
+	}
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	if _, _, err := mime.ParseMediaType(contentType); err != nil {
+		return nil, fmt.Errorf("parsing content type %q: %w", contentType, err)
+	}
+	header.Set("Content-Type", contentType)
+	return &Hook{tmpl, strings.ToUpper(method), header, contentType}, nil
+}
+
+func parseHeader(headers string) (http.Header, error) {
+	header := http.Header{}
+	scan := bufio.NewScanner(strings.NewReader(headers))
+	for scan.Scan() {
+		line := strings.TrimSpace(scan.Text())
+		if line == "" {
+			continue
+		}
+		colon := strings.IndexByte(line, ':')
+		if colon <= 0 {
+			return nil, fmt.Errorf("header %q is not Name: value", line)
+		}
+		header.Add(strings.TrimSpace(line[:colon]), strings.TrimSpace(line[colon+1:]))
+	}
+	return header, scan.Err()
+}
+
+type Request struct {
+	Method string
+	Header http.Header
+	Body   []byte
+}
+
+func (r Request) Submit(ctx context.Context, url string) error {
+	return notifier.Send(ctx, nil, r.Method, url, r.Header, bytes.NewReader(r.Body))
+}
+
+// failed reports an error rendering the request when it's submitted.
+type failed struct{ err error }
+
+func (f failed) Submit(context.Context, string) error { return f.err }
+
+func (h *Hook) BuildSubmitter(ctx context.Context, d *event.Detail) event.Submitter {
+	req, err := h.Build(d)
+	if err != nil {
+		return failed{err}
+	}
+	return req
+}
+
+func (h *Hook) Build(d *event.Detail) (*Request, error) {
+	if d == nil {
+		return nil, nil
+	}
+	body, err := h.render(d)
+	if err == nil {
+		err = h.validate(body)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Request{h.Method, h.Header, body}, nil
+}
+
+// DryRun writes the body that would be sent for the loaded event to w. Even a
+// body that fails validation is written, to help fix the template.
+func (h *Hook) DryRun(ctx context.Context, load notifier.EventLoader, w io.Writer) error {
+	d, err := load(ctx)
+	if err != nil || d == nil {
+		return err
+	}
+	body, err := h.render(d)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	return h.validate(body)
+}
+
+func (h *Hook) render(d *event.Detail) ([]byte, error) {
+	var body bytes.Buffer
+	if err := h.Template.Execute(&body, d); err != nil {
+		return nil, fmt.Errorf("rendering template: %w", err)
+	}
+	return body.Bytes(), nil
+}
+
+// validate checks that a body sent as JSON is well-formed.
+func (h *Hook) validate(body []byte) error {
+	media, _, _ := mime.ParseMediaType(h.ContentType)
+	if media != "application/json" && !strings.HasSuffix(media, "+json") {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Errorf("rendered template is not valid JSON: %w", err)
+	}
+	return nil
+}
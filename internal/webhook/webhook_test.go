@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MichaelUrman/notify/internal/event"
+)
+
+var detail = &event.Detail{
+	Summary:    "username pushed to dev",
+	Repository: "orgname/reponame",
+	Text:       `**username** pushed 1 commit to **dev**`,
+	Action:     []event.Action{{Name: "View", URL: "https://github.com/orgname/reponame"}},
+}
+
+const tmpl = `{"text": {{ json (plain .Text) }}, "repo": "{{ .Repository }}"{{ range .Action }}, "url": {{ json .URL }}{{ end }}}`
+
+func TestSubmit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method != http.MethodPut {
+			t.Errorf("method: got %s", r.Method)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer xyz" {
+			t.Errorf("authorization: got %q", got)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/vnd.api+json" {
+			t.Errorf("content type: got %q", got)
+		}
+		want := `{"text": "username pushed 1 commit to dev", "repo": "orgname/reponame", "url": "https://github.com/orgname/reponame"}`
+		if string(body) != want {
+			t.Errorf("body:\n got %s\nwant %s", body, want)
+		}
+	}))
+	defer srv.Close()
+
+	hook, err := New(tmpl, "put", "Authorization: Bearer xyz\n\nX-Empty:", "application/vnd.api+json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hook.BuildSubmitter(context.Background(), detail).Submit(context.Background(), srv.URL); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		tmpl, contentType string
+		ok                bool
+	}{
+		{`{"text": {{ json .Text }}}`, "", true},
+		{`{"text": "{{ .Text }}"}`, "", true},
+		{`{"text": {{ .Text }}}`, "", false},
+		{`{"text": {{ .Text }}}`, "application/json; charset=utf-8", false},
+		{`text={{ .Text }}`, "text/plain", true},
+	} {
+		hook, err := New(tt.tmpl, "", "", tt.contentType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = hook.Build(detail)
+		if ok := err == nil; ok != tt.ok {
+			t.Errorf("Build(%q, %q): got error %v", tt.tmpl, tt.contentType, err)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, tt := range []struct{ tmpl, headers, contentType string }{
+		{"", "", ""},
+		{"{{ .Text", "", ""},
+		{"{{ .Text }}", "Authorization Bearer xyz", ""},
+		{"{{ .Text }}", "", "application/"},
+	} {
+		if _, err := New(tt.tmpl, "", tt.headers, tt.contentType); err == nil {
+			t.Errorf("New(%q, %q, %q): want error", tt.tmpl, tt.headers, tt.contentType)
+		}
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	load := func(context.Context) (*event.Detail, error) { return detail, nil }
+	hook, err := New(`{"html": {{ json (markdownHTML .Text) }}} {{ .Summary }}`, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	err = hook.DryRun(context.Background(), load, &out)
+	if err == nil || !strings.Contains(err.Error(), "not valid JSON") {
+		t.Errorf("DryRun: got error %v", err)
+	}
+	want := `{"html": "<b>username</b> pushed 1 commit to <b>dev</b>"} username pushed to dev`
+	if out.String() != want {
+		t.Errorf("DryRun:\n got %s\nwant %s", out.String(), want)
+	}
+}